@@ -88,6 +88,74 @@ func TestDeriveSourceWithLabel(t *testing.T) {
 	}
 }
 
+func TestDeriveChildSource(t *testing.T) {
+	parent := mustDeriveSource(t, []byte("seed"), "root")
+	a1, err := DeriveChildSource(parent, "alpha")
+	if err != nil {
+		t.Fatalf("DeriveChildSource error: %v", err)
+	}
+	b1, err := DeriveChildSource(parent, "beta")
+	if err != nil {
+		t.Fatalf("DeriveChildSource error: %v", err)
+	}
+	// Reading from the parent must not change later children.
+	if _, err := parent.Read(make([]byte, 16)); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	a2, err := DeriveChildSource(parent, "alpha")
+	if err != nil {
+		t.Fatalf("DeriveChildSource error: %v", err)
+	}
+	grand, err := DeriveChildSource(a1, "alpha")
+	if err != nil {
+		t.Fatalf("DeriveChildSource error: %v", err)
+	}
+
+	bufA1 := make([]byte, 32)
+	bufA2 := make([]byte, 32)
+	bufB1 := make([]byte, 32)
+	bufG := make([]byte, 32)
+	for _, r := range []struct {
+		src core.Source
+		buf []byte
+	}{{a1, bufA1}, {a2, bufA2}, {b1, bufB1}, {grand, bufG}} {
+		if _, err := r.src.Read(r.buf); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+	}
+	if !bytes.Equal(bufA1, bufA2) {
+		t.Fatalf("same info produced different output")
+	}
+	if bytes.Equal(bufA1, bufB1) || bytes.Equal(bufA1, bufG) {
+		t.Fatalf("distinct children produced identical output")
+	}
+}
+
+func TestDeriveChildSourceFromReader(t *testing.T) {
+	parent := &countingSourceStub{}
+	if _, err := DeriveChildSource(parent, "x"); err != nil {
+		t.Fatalf("DeriveChildSource error: %v", err)
+	}
+	if parent.read != childSeedLen {
+		t.Fatalf("read %d bytes from parent want %d", parent.read, childSeedLen)
+	}
+	if _, err := DeriveChildSource(nil, "x"); !errors.Is(err, core.ErrNilSource) {
+		t.Fatalf("nil parent err=%v want ErrNilSource", err)
+	}
+	closed := mustDeriveSource(t, []byte("seed"), "closed")
+	_ = closed.(io.Closer).Close()
+	if _, err := DeriveChildSource(closed, "x"); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("closed parent err=%v want ErrSourceClosed", err)
+	}
+}
+
+type countingSourceStub struct{ read int }
+
+func (c *countingSourceStub) Read(p []byte) (int, error) {
+	c.read += len(p)
+	return len(p), nil
+}
+
 func TestChaChaSourceReturnsErrorWhenExhausted(t *testing.T) {
 	var key [32]byte
 	var nonce [12]byte
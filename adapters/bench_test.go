@@ -22,3 +22,15 @@ func BenchmarkDerivedSourceRead(b *testing.B) {
 		_, _ = src.Read(buf)
 	}
 }
+
+func BenchmarkXoshiro256SourceRead(b *testing.B) {
+	src, err := Xoshiro256Source(1)
+	if err != nil {
+		b.Skipf("Xoshiro256Source error: %v", err)
+	}
+	buf := make([]byte, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = src.Read(buf)
+	}
+}
@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestChaChaSourceSnapshotRestore(t *testing.T) {
+	gen := core.New(mustDeriveSource(t, []byte("seed"), "snapshot"))
+	if _, err := gen.Bytes(100); err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+	state, err := gen.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot error: %v", err)
+	}
+	want, err := gen.Bytes(200)
+	if err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+
+	resumed := core.New(mustDeriveSource(t, []byte("other"), "fresh"))
+	if err := resumed.Restore(state); err != nil {
+		t.Fatalf("Restore error: %v", err)
+	}
+	got, err := resumed.Bytes(200)
+	if err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("restored stream diverged")
+	}
+}
+
+func TestChaChaSourceRestoreInvalid(t *testing.T) {
+	gen := core.New(mustDeriveSource(t, []byte("seed"), "snapshot"))
+	if err := gen.Restore([]byte{1, 2, 3}); !errors.Is(err, core.ErrInvalidSnapshot) {
+		t.Fatalf("Restore err=%v want ErrInvalidSnapshot", err)
+	}
+	state, err := gen.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot error: %v", err)
+	}
+	state[0] = 99
+	if err := gen.Restore(state); !errors.Is(err, core.ErrInvalidSnapshot) {
+		t.Fatalf("Restore bad version err=%v want ErrInvalidSnapshot", err)
+	}
+	if _, err := core.New(nil).Snapshot(); !errors.Is(err, core.ErrSnapshotUnsupported) {
+		t.Fatalf("Snapshot crypto source err=%v want ErrSnapshotUnsupported", err)
+	}
+}
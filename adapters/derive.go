@@ -12,6 +12,8 @@ import (
 const (
 	deriveSalt       = "randutil hkdf v1"
 	deriveInfoPrefix = "randutil derive v1 "
+	childInfoPrefix  = "randutil child v1 "
+	childSeedLen     = 32
 )
 
 // DeriveSource returns a domain-separated stream derived from seed and label.
@@ -37,12 +39,54 @@ func DeriveRNG(seed []byte, label string) (core.RNG, error) {
 	return core.New(src), nil
 }
 
-func deriveKeyNonce(seed []byte, label string) ([32]byte, [12]byte, error) {
-	info := []byte(deriveInfoPrefix)
-	if label != "" {
-		info = append(info, label...)
+// DeriveChildSource returns an independent stream derived from parent and info
+// with HKDF-SHA256, so streams can be spawned hierarchically from one master
+// seed. If parent was returned by DeriveSource, DeterministicSource or
+// DeriveChildSource, the child is derived from the parent's key material
+// without advancing it, so children do not depend on derivation order.
+// Otherwise 32 bytes are read from parent and used as the child's seed.
+// Children share the 256 GiB output limit of DeriveSource.
+func DeriveChildSource(parent core.Source, info string) (core.Source, error) {
+	if parent == nil {
+		return nil, core.ErrNilSource
+	}
+	seed, err := childSeed(parent)
+	if err != nil {
+		return nil, err
+	}
+	defer core.Zero(seed)
+	key, nonce, err := hkdfKeyNonce(seed, childInfoPrefix+info)
+	if err != nil {
+		return nil, err
+	}
+	return newChaChaSource(key, nonce)
+}
+
+func childSeed(parent core.Source) ([]byte, error) {
+	if c, ok := parent.(*chachaSource); ok {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.closed || c.cipher == nil {
+			return nil, core.ErrSourceClosed
+		}
+		seed := make([]byte, 0, len(c.key)+len(c.nonce))
+		seed = append(seed, c.key[:]...)
+		return append(seed, c.nonce[:]...), nil
 	}
-	reader := hkdf.New(sha256.New, seed, []byte(deriveSalt), info)
+	seed := make([]byte, childSeedLen)
+	if _, err := io.ReadFull(parent, seed); err != nil {
+		core.Zero(seed)
+		return nil, err
+	}
+	return seed, nil
+}
+
+func deriveKeyNonce(seed []byte, label string) ([32]byte, [12]byte, error) {
+	return hkdfKeyNonce(seed, deriveInfoPrefix+label)
+}
+
+func hkdfKeyNonce(seed []byte, info string) ([32]byte, [12]byte, error) {
+	reader := hkdf.New(sha256.New, seed, []byte(deriveSalt), []byte(info))
 	var out [44]byte
 	if _, err := io.ReadFull(reader, out[:]); err != nil {
 		return [32]byte{}, [12]byte{}, err
@@ -0,0 +1,122 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/chacha20"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// maxRatchetBytes leaves room under the ChaCha20 counter limit for the
+// keystream consumed by a re-key.
+const maxRatchetBytes = maxChaChaSourceBytes - 64
+
+type ratchetSource struct {
+	mu       sync.Mutex
+	cipher   *chacha20.Cipher
+	used     uint64
+	interval ReseedInterval
+	keyedAt  time.Time
+	now      func() time.Time
+	closed   bool
+}
+
+// DeterministicSourceWithReseed returns a reproducible ChaCha20 stream based
+// on seed that re-keys itself from its own keystream whenever interval
+// elapses and drops the previous cipher, so the current key cannot be used
+// to reconstruct output produced before the last re-key. Intermediate key
+// buffers are zeroed, but chacha20.Cipher offers no way to wipe its own state,
+// so a dropped cipher's key stays in memory until it is garbage-collected.
+//
+// WARNING: This is deterministic. DO NOT USE FOR TOKENS / AUTH unless the seed
+// is high-entropy and kept secret. Intended for tests, benchmarks, and
+// replayable simulations.
+//
+// Returns core.ErrNonPositiveBound if interval has no trigger and an error
+// when policy mode disables deterministic sources.
+func DeterministicSourceWithReseed(seed []byte, interval ReseedInterval) (core.Source, error) {
+	if interval.Bytes == 0 && interval.Period <= 0 {
+		return nil, core.ErrNonPositiveBound
+	}
+	if interval.Bytes == 0 || interval.Bytes > maxRatchetBytes {
+		interval.Bytes = maxRatchetBytes
+	}
+	key := deriveKey(seed, "key")
+	nonceKey := deriveKey(seed, "nonce")
+	defer core.Zero(key[:])
+	defer core.Zero(nonceKey[:])
+	r := &ratchetSource{interval: interval, now: time.Now}
+	if err := r.install(key[:], nonceKey[:12]); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *ratchetSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return 0, core.ErrSourceClosed
+	}
+	if r.interval.Period > 0 && r.now().Sub(r.keyedAt) >= r.interval.Period {
+		if err := r.rekey(); err != nil {
+			return 0, err
+		}
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	n := 0
+	for n < len(p) {
+		if r.used == r.interval.Bytes {
+			if err := r.rekey(); err != nil {
+				core.Zero(p)
+				return 0, err
+			}
+		}
+		chunk := p[n:]
+		if remaining := r.interval.Bytes - r.used; uint64(len(chunk)) > remaining {
+			chunk = chunk[:remaining]
+		}
+		r.cipher.XORKeyStream(chunk, chunk)
+		r.used += uint64(len(chunk))
+		n += len(chunk)
+	}
+	return n, nil
+}
+
+// rekey replaces the cipher with one keyed from the next 44 keystream bytes,
+// which are never served to callers.
+func (r *ratchetSource) rekey() error {
+	var next [44]byte
+	defer core.Zero(next[:])
+	r.cipher.XORKeyStream(next[:], next[:])
+	return r.install(next[:32], next[32:])
+}
+
+func (r *ratchetSource) install(key []byte, nonce []byte) error {
+	c, err := chacha20.NewUnauthenticatedCipher(key, nonce)
+	if err != nil {
+		return err
+	}
+	r.cipher = c
+	r.used = 0
+	r.keyedAt = r.now()
+	return nil
+}
+
+func (r *ratchetSource) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	r.cipher = nil
+	return nil
+}
@@ -0,0 +1,119 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/chacha20"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestDeterministicSourceWithReseedRatchets(t *testing.T) {
+	seed := []byte("seed")
+	src, err := DeterministicSourceWithReseed(seed, ReseedInterval{Bytes: 64})
+	if err != nil {
+		t.Fatalf("DeterministicSourceWithReseed error: %v", err)
+	}
+	got := make([]byte, 100)
+	if _, err := io.ReadFull(src, got); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+
+	// The first key matches DeterministicSource; the next key comes from the
+	// 44 keystream bytes that follow the first interval and are never served.
+	plain := make([]byte, 64+44)
+	if _, err := io.ReadFull(mustDeterministicSource(t, seed), plain); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	c, err := chacha20.NewUnauthenticatedCipher(plain[64:96], plain[96:108])
+	if err != nil {
+		t.Fatalf("NewUnauthenticatedCipher error: %v", err)
+	}
+	want := append([]byte(nil), plain[:64]...)
+	next := make([]byte, 36)
+	c.XORKeyStream(next, next)
+	want = append(want, next...)
+	if !bytes.Equal(got, want) {
+		t.Fatalf("output=%x want %x", got, want)
+	}
+}
+
+func TestDeterministicSourceWithReseedChunkingInvariant(t *testing.T) {
+	seed := []byte("seed")
+	a, err := DeterministicSourceWithReseed(seed, ReseedInterval{Bytes: 10})
+	if err != nil {
+		t.Fatalf("DeterministicSourceWithReseed error: %v", err)
+	}
+	b, err := DeterministicSourceWithReseed(seed, ReseedInterval{Bytes: 10})
+	if err != nil {
+		t.Fatalf("DeterministicSourceWithReseed error: %v", err)
+	}
+	whole := make([]byte, 57)
+	if _, err := a.Read(whole); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	var pieces []byte
+	for _, n := range []int{3, 7, 1, 20, 26} {
+		buf := make([]byte, n)
+		if _, err := b.Read(buf); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		pieces = append(pieces, buf...)
+	}
+	if !bytes.Equal(whole, pieces) {
+		t.Fatalf("chunked output differs from single read")
+	}
+}
+
+func TestDeterministicSourceWithReseedPeriod(t *testing.T) {
+	src, err := DeterministicSourceWithReseed([]byte("seed"), ReseedInterval{Period: time.Minute})
+	if err != nil {
+		t.Fatalf("DeterministicSourceWithReseed error: %v", err)
+	}
+	r := src.(*ratchetSource)
+	clock := time.Unix(0, 0)
+	r.now = func() time.Time { return clock }
+	r.keyedAt = clock
+	first := make([]byte, 16)
+	if _, err := r.Read(first); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	clock = clock.Add(time.Minute)
+	second := make([]byte, 16)
+	if _, err := r.Read(second); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	plain := make([]byte, 32)
+	if _, err := io.ReadFull(mustDeterministicSource(t, []byte("seed")), plain); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	if !bytes.Equal(first, plain[:16]) || bytes.Equal(second, plain[16:]) {
+		t.Fatalf("expected a re-key after the period elapsed")
+	}
+	if !r.keyedAt.Equal(clock) {
+		t.Fatalf("keyedAt=%v want %v", r.keyedAt, clock)
+	}
+}
+
+func TestDeterministicSourceWithReseedErrors(t *testing.T) {
+	if _, err := DeterministicSourceWithReseed([]byte("seed"), ReseedInterval{}); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("empty interval err=%v want ErrNonPositiveBound", err)
+	}
+	src, err := DeterministicSourceWithReseed([]byte("seed"), ReseedInterval{Bytes: 8})
+	if err != nil {
+		t.Fatalf("DeterministicSourceWithReseed error: %v", err)
+	}
+	if err := src.(io.Closer).Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if _, err := src.Read(make([]byte, 1)); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("Read after Close err=%v want ErrSourceClosed", err)
+	}
+}
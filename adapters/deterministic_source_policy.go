@@ -14,3 +14,13 @@ func DeterministicSource(_ []byte) (core.Source, error) {
 func DeterministicSourceWithLabel(_ []byte, _ string) (core.Source, error) {
 	return nil, core.ErrDeterministicDisabled
 }
+
+// DeterministicSourceWithReseed returns an error when policy mode is enabled.
+func DeterministicSourceWithReseed(_ []byte, _ ReseedInterval) (core.Source, error) {
+	return nil, core.ErrDeterministicDisabled
+}
+
+// PassphraseSource returns an error when policy mode is enabled.
+func PassphraseSource(_ string, _ []byte) (core.Source, error) {
+	return nil, core.ErrDeterministicDisabled
+}
@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+const (
+	ctrKeyLen   = 32
+	ctrBlockLen = aes.BlockSize
+	ctrSeedLen  = ctrKeyLen + ctrBlockLen
+)
+
+// CTRDRBG returns an SP 800-90A CTR_DRBG using AES-256 with the derivation
+// function. seed is the entropy input followed by the nonce and must be at
+// least 48 bytes; if seed is nil, 48 bytes are read from crypto/rand.
+// personalization may be nil.
+func CTRDRBG(seed []byte, personalization []byte) (*DRBG, error) {
+	return CTRDRBGWithOptions(seed, personalization, DRBGOptions{})
+}
+
+// CTRDRBGWithOptions is like CTRDRBG but configures reseeding and prediction
+// resistance. If seed is nil, it is read from opts.Entropy.
+func CTRDRBGWithOptions(seed []byte, personalization []byte, opts DRBGOptions) (*DRBG, error) {
+	return newDRBG(seed, opts, func(seed []byte) drbgMechanism {
+		c := &ctrDRBG{}
+		c.setKey(make([]byte, ctrKeyLen))
+		input := concat(seed, personalization)
+		material := ctrDF(input)
+		c.update(material[:])
+		core.Zero(input)
+		core.Zero(material[:])
+		return c
+	})
+}
+
+type ctrDRBG struct {
+	key   [ctrKeyLen]byte
+	v     [ctrBlockLen]byte
+	block cipher.Block
+}
+
+func (c *ctrDRBG) setKey(key []byte) {
+	copy(c.key[:], key)
+	block, err := aes.NewCipher(c.key[:])
+	if err != nil {
+		// AES-256 keys are always valid.
+		panic(err)
+	}
+	c.block = block
+}
+
+// update is CTR_DRBG_Update (SP 800-90A section 10.2.1.2).
+func (c *ctrDRBG) update(provided []byte) {
+	var temp [ctrSeedLen]byte
+	for i := 0; i < ctrSeedLen; i += ctrBlockLen {
+		incrementBE(c.v[:])
+		c.block.Encrypt(temp[i:i+ctrBlockLen], c.v[:])
+	}
+	for i := range temp {
+		temp[i] ^= provided[i]
+	}
+	c.setKey(temp[:ctrKeyLen])
+	copy(c.v[:], temp[ctrKeyLen:])
+	core.Zero(temp[:])
+}
+
+func (c *ctrDRBG) reseed(entropy []byte, additional []byte) {
+	input := concat(entropy, additional)
+	material := ctrDF(input)
+	c.update(material[:])
+	core.Zero(input)
+	core.Zero(material[:])
+}
+
+func (c *ctrDRBG) generate(out []byte, additional []byte, _ uint64) {
+	var material [ctrSeedLen]byte
+	if len(additional) > 0 {
+		material = ctrDF(additional)
+		c.update(material[:])
+	}
+	var block [ctrBlockLen]byte
+	for i := 0; i < len(out); i += ctrBlockLen {
+		incrementBE(c.v[:])
+		c.block.Encrypt(block[:], c.v[:])
+		copy(out[i:], block[:])
+	}
+	core.Zero(block[:])
+	c.update(material[:])
+	core.Zero(material[:])
+}
+
+func (c *ctrDRBG) zero() {
+	core.Zero(c.key[:])
+	core.Zero(c.v[:])
+	c.block = nil
+}
+
+// ctrDF is Block_Cipher_df (SP 800-90A section 10.3.2) returning seedlen bits.
+func ctrDF(input []byte) [ctrSeedLen]byte {
+	s := make([]byte, 0, 8+len(input)+1+ctrBlockLen)
+	// #nosec G115 -- inputs are far below 4 GiB.
+	s = binary.BigEndian.AppendUint32(s, uint32(len(input)))
+	s = binary.BigEndian.AppendUint32(s, ctrSeedLen)
+	s = append(s, input...)
+	s = append(s, 0x80)
+	for len(s)%ctrBlockLen != 0 {
+		s = append(s, 0)
+	}
+
+	var k [ctrKeyLen]byte
+	for i := range k {
+		k[i] = byte(i)
+	}
+	block, _ := aes.NewCipher(k[:])
+	var temp [ctrSeedLen]byte
+	for i := 0; i*ctrBlockLen < ctrSeedLen; i++ {
+		var iv [ctrBlockLen]byte
+		// #nosec G115 -- i < 3.
+		binary.BigEndian.PutUint32(iv[:], uint32(i))
+		chain := bcc(block, iv[:], s)
+		copy(temp[i*ctrBlockLen:], chain[:])
+	}
+	core.Zero(s)
+
+	block, _ = aes.NewCipher(temp[:ctrKeyLen])
+	x := temp[ctrKeyLen:]
+	var out [ctrSeedLen]byte
+	for i := 0; i < ctrSeedLen; i += ctrBlockLen {
+		block.Encrypt(out[i:i+ctrBlockLen], x)
+		x = out[i : i+ctrBlockLen]
+	}
+	core.Zero(temp[:])
+	return out
+}
+
+// bcc is the BCC function (SP 800-90A section 10.3.3) applied to iv || data.
+func bcc(block cipher.Block, iv []byte, data []byte) [ctrBlockLen]byte {
+	var chain [ctrBlockLen]byte
+	feed := func(b []byte) {
+		for i := range chain {
+			chain[i] ^= b[i]
+		}
+		block.Encrypt(chain[:], chain[:])
+	}
+	feed(iv)
+	for i := 0; i < len(data); i += ctrBlockLen {
+		feed(data[i : i+ctrBlockLen])
+	}
+	return chain
+}
+
+func incrementBE(v []byte) {
+	for i := len(v) - 1; i >= 0; i-- {
+		v[i]++
+		if v[i] != 0 {
+			return
+		}
+	}
+}
+
+func concat(parts ...[]byte) []byte {
+	n := 0
+	for _, p := range parts {
+		n += len(p)
+	}
+	out := make([]byte, 0, n)
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+const hashSeedLen = 440 / 8
+
+// HashDRBG returns an SP 800-90A Hash_DRBG using SHA-256. seed is the entropy
+// input followed by the nonce and must be at least 48 bytes; if seed is nil,
+// 48 bytes are read from crypto/rand. personalization may be nil.
+func HashDRBG(seed []byte, personalization []byte) (*DRBG, error) {
+	return HashDRBGWithOptions(seed, personalization, DRBGOptions{})
+}
+
+// HashDRBGWithOptions is like HashDRBG but configures reseeding and
+// prediction resistance. If seed is nil, it is read from opts.Entropy.
+func HashDRBGWithOptions(seed []byte, personalization []byte, opts DRBGOptions) (*DRBG, error) {
+	return newDRBG(seed, opts, func(seed []byte) drbgMechanism {
+		h := &hashDRBG{}
+		input := concat(seed, personalization)
+		h.v = hashDF(input)
+		h.c = hashDF(concat([]byte{0x00}, h.v[:]))
+		core.Zero(input)
+		return h
+	})
+}
+
+type hashDRBG struct {
+	v [hashSeedLen]byte
+	c [hashSeedLen]byte
+}
+
+func (h *hashDRBG) reseed(entropy []byte, additional []byte) {
+	input := concat([]byte{0x01}, h.v[:], entropy, additional)
+	h.v = hashDF(input)
+	h.c = hashDF(concat([]byte{0x00}, h.v[:]))
+	core.Zero(input)
+}
+
+func (h *hashDRBG) generate(out []byte, additional []byte, reseedCounter uint64) {
+	if len(additional) > 0 {
+		w := sha256.Sum256(concat([]byte{0x02}, h.v[:], additional))
+		addBE(h.v[:], w[:])
+	}
+	data := h.v
+	var block [sha256.Size]byte
+	for i := 0; i < len(out); i += sha256.Size {
+		block = sha256.Sum256(data[:])
+		copy(out[i:], block[:])
+		incrementBE(data[:])
+	}
+	core.Zero(data[:])
+	core.Zero(block[:])
+
+	hv := sha256.Sum256(concat([]byte{0x03}, h.v[:]))
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], reseedCounter)
+	addBE(h.v[:], hv[:])
+	addBE(h.v[:], h.c[:])
+	addBE(h.v[:], counter[:])
+}
+
+func (h *hashDRBG) zero() {
+	core.Zero(h.v[:])
+	core.Zero(h.c[:])
+}
+
+// hashDF is Hash_df (SP 800-90A section 10.3.1) returning seedlen bits.
+func hashDF(input []byte) [hashSeedLen]byte {
+	var out [hashSeedLen]byte
+	var prefix [5]byte
+	binary.BigEndian.PutUint32(prefix[1:], hashSeedLen*8)
+	for i := 0; i*sha256.Size < hashSeedLen; i++ {
+		// #nosec G115 -- i < 2.
+		prefix[0] = byte(i + 1)
+		h := sha256.New()
+		h.Write(prefix[:])
+		h.Write(input)
+		copy(out[i*sha256.Size:], h.Sum(nil))
+	}
+	return out
+}
+
+// addBE sets dst = (dst + src) mod 2^(8*len(dst)), treating both as
+// big-endian integers with src right-aligned.
+func addBE(dst []byte, src []byte) {
+	var carry uint16
+	j := len(src) - 1
+	for i := len(dst) - 1; i >= 0; i-- {
+		sum := uint16(dst[i]) + carry
+		if j >= 0 {
+			sum += uint16(src[j])
+			j--
+		}
+		dst[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
@@ -2,32 +2,120 @@ package adapters
 
 import (
 	"io"
+	"sync"
 
 	"github.com/aatuh/randutil/v2/core"
 )
 
-const fastDeriveLabel = "randutil fast v1"
+const (
+	fastDeriveLabel = "randutil fast v1"
+	// fastRekeyBytes is how much output a FastSource serves from one key
+	// before drawing a fresh seed from its parent source.
+	fastRekeyBytes = 1 << 20
+)
+
+type fastSource struct {
+	mu       sync.Mutex
+	parent   core.Source
+	cur      core.Source
+	used     uint64
+	interval uint64
+	closed   bool
+}
 
-// FastSource returns a fast CSPRNG source derived from crypto/rand. It inherits
-// the 256 GiB per-stream limit of DeriveSource. For strict FIPS/OS RNG
+// FastSource returns a fast CSPRNG source: a ChaCha20 stream keyed from
+// crypto/rand and rekeyed with a fresh crypto/rand seed after every MiB of
+// output, so one getrandom call is amortized over many reads and earlier
+// output cannot be recovered from the current key. For strict FIPS/OS RNG
 // compliance, use crypto/rand.Reader directly.
 func FastSource() (core.Source, error) {
 	return FastSourceWithSource(nil)
 }
 
-// FastSourceWithSource returns a fast CSPRNG source derived from src. If src is
-// nil, crypto/rand.Reader is used.
+// FastSourceWithSource returns a fast CSPRNG source keyed and periodically
+// rekeyed from src. If src is nil, crypto/rand.Reader is used. src must be
+// safe for use by the returned source for as long as it is read.
 func FastSourceWithSource(src core.Source) (core.Source, error) {
+	f, err := newFastSource(src, fastRekeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func newFastSource(src core.Source, interval uint64) (*fastSource, error) {
 	if src == nil {
 		src = CryptoSource()
 	}
-	var seed [32]byte
-	if _, err := io.ReadFull(src, seed[:]); err != nil {
+	f := &fastSource{parent: src, interval: interval}
+	if err := f.rekey(); err != nil {
 		return nil, err
 	}
+	return f, nil
+}
+
+// rekey replaces the current stream with one derived from a fresh parent
+// seed. Callers hold f.mu or own f exclusively.
+func (f *fastSource) rekey() error {
+	var seed [32]byte
+	if _, err := io.ReadFull(f.parent, seed[:]); err != nil {
+		return err
+	}
 	derived, err := DeriveSource(seed[:], fastDeriveLabel)
 	core.Zero(seed[:])
-	return derived, err
+	if err != nil {
+		return err
+	}
+	if closer, ok := f.cur.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	f.cur = derived
+	f.used = 0
+	return nil
+}
+
+func (f *fastSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return 0, core.ErrSourceClosed
+	}
+	total := 0
+	for total < len(p) {
+		if f.used >= f.interval {
+			if err := f.rekey(); err != nil {
+				return total, err
+			}
+		}
+		chunk := p[total:]
+		if remaining := f.interval - f.used; uint64(len(chunk)) > remaining {
+			chunk = chunk[:remaining]
+		}
+		n, err := io.ReadFull(f.cur, chunk)
+		total += n
+		// #nosec G115 -- n is a non-negative byte count.
+		f.used += uint64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (f *fastSource) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	if closer, ok := f.cur.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
 }
 
 // FastRNG returns a fast CSPRNG RNG derived from crypto/rand.
@@ -2,9 +2,11 @@ package adapters
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"testing"
 
+	"github.com/aatuh/randutil/v2/core"
 	"github.com/aatuh/randutil/v2/internal/testutil"
 )
 
@@ -35,3 +37,40 @@ func TestFastSourceWithSourceDeterministic(t *testing.T) {
 		t.Fatalf("fast sources mismatch")
 	}
 }
+
+func TestFastSourceRekeysFromParent(t *testing.T) {
+	parent := NewCountingSource(CryptoSource(), nil)
+	fast, err := newFastSource(parent, 64)
+	if err != nil {
+		t.Fatalf("newFastSource error: %v", err)
+	}
+	if got := parent.Count(); got != 32 {
+		t.Fatalf("initial seed read %d bytes want 32", got)
+	}
+	buf := make([]byte, 200)
+	if _, err := io.ReadFull(fast, buf); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	// 200 bytes span four 64-byte keys: the initial one plus three rekeys.
+	if got := parent.Count(); got != 4*32 {
+		t.Fatalf("parent read %d bytes want %d", got, 4*32)
+	}
+	if err := fast.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if _, err := fast.Read(buf); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("Read after Close err=%v want ErrSourceClosed", err)
+	}
+}
+
+func TestFastSourceRekeyFailure(t *testing.T) {
+	seed := make([]byte, 32)
+	fast, err := newFastSource(ReplaySource(seed), 16)
+	if err != nil {
+		t.Fatalf("newFastSource error: %v", err)
+	}
+	n, err := fast.Read(make([]byte, 32))
+	if n != 16 || err == nil {
+		t.Fatalf("Read=%d,%v want 16 bytes and rekey error", n, err)
+	}
+}
@@ -0,0 +1,129 @@
+package adapters
+
+import (
+	"io"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// FaultOptions configures the faults injected by FaultySource. Probabilities
+// are evaluated independently on every non-empty Read; zero values disable
+// the corresponding fault.
+type FaultOptions struct {
+	// ShortReadProbability is the chance that a Read returns fewer bytes than
+	// requested (at least one).
+	ShortReadProbability float64
+	// ErrorProbability is the chance that a Read fails with Err.
+	ErrorProbability float64
+	// ErrorEvery makes every Nth Read fail with Err.
+	ErrorEvery uint
+	// Err is the injected error. Defaults to core.ErrInjectedFault.
+	Err error
+	// EOFProbability is the chance that a Read returns io.EOF.
+	EOFProbability float64
+	// DelayProbability is the chance that a Read sleeps for Delay first.
+	DelayProbability float64
+	// Delay is the injected latency.
+	Delay time.Duration
+	// Seed seeds the fault decisions so failures are reproducible.
+	Seed uint64
+}
+
+// faultSeedStream selects the PCG stream used for fault decisions.
+const faultSeedStream = 0x6661756c74792121
+
+type faultySource struct {
+	mu    sync.Mutex
+	src   io.Reader
+	opts  FaultOptions
+	rng   *rand.Rand
+	reads uint
+	sleep func(time.Duration)
+}
+
+// FaultySource wraps src and injects short reads, errors, EOFs and delays as
+// configured by opts, so callers can exercise their handling of entropy
+// failures. Faults are decided by a non-cryptographic generator seeded with
+// opts.Seed; the bytes that are served always come from src. Close closes src
+// if it implements io.Closer.
+//
+// It returns core.ErrNilSource if src is nil, core.ErrInvalidProbability if a
+// probability is outside [0,1], and core.ErrNegativeDuration if opts.Delay is
+// negative.
+func FaultySource(src io.Reader, opts FaultOptions) (core.Source, error) {
+	if src == nil {
+		return nil, core.ErrNilSource
+	}
+	for _, p := range []float64{
+		opts.ShortReadProbability,
+		opts.ErrorProbability,
+		opts.EOFProbability,
+		opts.DelayProbability,
+	} {
+		if !(p >= 0 && p <= 1) {
+			return nil, core.ErrInvalidProbability
+		}
+	}
+	if opts.Delay < 0 {
+		return nil, core.ErrNegativeDuration
+	}
+	if opts.Err == nil {
+		opts.Err = core.ErrInjectedFault
+	}
+	return &faultySource{
+		src:   src,
+		opts:  opts,
+		rng:   rand.New(rand.NewPCG(opts.Seed, faultSeedStream)),
+		sleep: time.Sleep,
+	}, nil
+}
+
+func (f *faultySource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	delay, n, err := f.decide(len(p))
+	if delay {
+		f.sleep(f.opts.Delay)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return f.src.Read(p[:n])
+}
+
+// decide draws the faults for one Read and returns whether to delay and how
+// many bytes to read, or the error to inject.
+func (f *faultySource) decide(size int) (delay bool, n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reads++
+	delay = f.hit(f.opts.DelayProbability) && f.opts.Delay > 0
+	switch {
+	case f.opts.ErrorEvery > 0 && f.reads%f.opts.ErrorEvery == 0:
+		return delay, 0, f.opts.Err
+	case f.hit(f.opts.EOFProbability):
+		return delay, 0, io.EOF
+	case f.hit(f.opts.ErrorProbability):
+		return delay, 0, f.opts.Err
+	}
+	n = size
+	if size > 1 && f.hit(f.opts.ShortReadProbability) {
+		n = 1 + f.rng.IntN(size-1)
+	}
+	return delay, n, nil
+}
+
+func (f *faultySource) hit(p float64) bool {
+	return p > 0 && f.rng.Float64() < p
+}
+
+func (f *faultySource) Close() error {
+	if c, ok := f.src.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestFaultySourcePassThrough(t *testing.T) {
+	src, err := FaultySource(testutil.NewSeqReader([]byte{7}), FaultOptions{})
+	if err != nil {
+		t.Fatalf("FaultySource error: %v", err)
+	}
+	buf := make([]byte, 16)
+	if n, err := src.Read(buf); n != len(buf) || err != nil {
+		t.Fatalf("Read n=%d err=%v want %d nil", n, err, len(buf))
+	}
+}
+
+func TestFaultySourceErrorEvery(t *testing.T) {
+	src, err := FaultySource(testutil.NewSeqReader([]byte{1}), FaultOptions{ErrorEvery: 3})
+	if err != nil {
+		t.Fatalf("FaultySource error: %v", err)
+	}
+	buf := make([]byte, 4)
+	for i := 1; i <= 9; i++ {
+		_, err := src.Read(buf)
+		if wantFail := i%3 == 0; wantFail != errors.Is(err, core.ErrInjectedFault) {
+			t.Fatalf("read %d err=%v wantFail=%v", i, err, wantFail)
+		}
+	}
+}
+
+func TestFaultySourceProbabilities(t *testing.T) {
+	custom := errors.New("boom")
+	cases := []struct {
+		name string
+		opts FaultOptions
+		want error
+	}{
+		{"error", FaultOptions{ErrorProbability: 1, Err: custom}, custom},
+		{"eof", FaultOptions{EOFProbability: 1}, io.EOF},
+	}
+	for _, tc := range cases {
+		src, err := FaultySource(testutil.NewSeqReader([]byte{1}), tc.opts)
+		if err != nil {
+			t.Fatalf("%s: FaultySource error: %v", tc.name, err)
+		}
+		if n, err := src.Read(make([]byte, 8)); n != 0 || !errors.Is(err, tc.want) {
+			t.Fatalf("%s: Read n=%d err=%v want 0 %v", tc.name, n, err, tc.want)
+		}
+	}
+
+	src, err := FaultySource(testutil.NewSeqReader([]byte{1}), FaultOptions{ShortReadProbability: 1})
+	if err != nil {
+		t.Fatalf("FaultySource error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		n, err := src.Read(make([]byte, 8))
+		if err != nil || n < 1 || n >= 8 {
+			t.Fatalf("short read n=%d err=%v want 1..7", n, err)
+		}
+	}
+	// io.ReadFull still succeeds across short reads.
+	if _, err := io.ReadFull(src, make([]byte, 64)); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+}
+
+func TestFaultySourceDelayAndSeed(t *testing.T) {
+	opts := FaultOptions{DelayProbability: 0.5, Delay: time.Second, ErrorProbability: 0.3, Seed: 9}
+	pattern := func() []bool {
+		src, err := FaultySource(testutil.NewSeqReader([]byte{1}), opts)
+		if err != nil {
+			t.Fatalf("FaultySource error: %v", err)
+		}
+		fs := src.(*faultySource)
+		var slept []time.Duration
+		fs.sleep = func(d time.Duration) { slept = append(slept, d) }
+		var out []bool
+		for i := 0; i < 32; i++ {
+			n := len(slept)
+			_, err := src.Read(make([]byte, 4))
+			out = append(out, err != nil, len(slept) > n)
+		}
+		for _, d := range slept {
+			if d != time.Second {
+				t.Fatalf("slept %v want 1s", d)
+			}
+		}
+		if len(slept) == 0 {
+			t.Fatalf("no delays injected")
+		}
+		return out
+	}
+	a, b := pattern(), pattern()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed produced different fault pattern")
+		}
+	}
+}
+
+func TestFaultySourceValidation(t *testing.T) {
+	if _, err := FaultySource(nil, FaultOptions{}); !errors.Is(err, core.ErrNilSource) {
+		t.Fatalf("nil src err=%v want ErrNilSource", err)
+	}
+	if _, err := FaultySource(testutil.NewSeqReader(), FaultOptions{EOFProbability: 1.5}); !errors.Is(err, core.ErrInvalidProbability) {
+		t.Fatalf("bad probability err=%v want ErrInvalidProbability", err)
+	}
+	if _, err := FaultySource(testutil.NewSeqReader(), FaultOptions{Delay: -1}); !errors.Is(err, core.ErrNegativeDuration) {
+		t.Fatalf("negative delay err=%v want ErrNegativeDuration", err)
+	}
+}
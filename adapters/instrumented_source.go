@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"expvar"
+	"io"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// MetricsCollector receives one observation per Read of an instrumented
+// source. Implementations must be safe for concurrent use; adapters for
+// Prometheus or OpenTelemetry can map the fields onto their own counters and
+// histograms.
+type MetricsCollector interface {
+	// ObserveRead records a Read that served n bytes, returned err (nil on
+	// success) and took latency.
+	ObserveRead(n int, err error, latency time.Duration)
+}
+
+type instrumentedSource struct {
+	src       core.Source
+	collector MetricsCollector
+	now       func() time.Time
+}
+
+// InstrumentedSource wraps src and reports every non-empty Read to collector.
+// Close closes src if it implements io.Closer. If collector is nil, src is
+// returned unchanged. It returns core.ErrNilSource if src is nil.
+func InstrumentedSource(src core.Source, collector MetricsCollector) (core.Source, error) {
+	if src == nil {
+		return nil, core.ErrNilSource
+	}
+	if collector == nil {
+		return src, nil
+	}
+	return &instrumentedSource{src: src, collector: collector, now: time.Now}, nil
+}
+
+func (s *instrumentedSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	start := s.now()
+	n, err := s.src.Read(p)
+	s.collector.ObserveRead(n, err, s.now().Sub(start))
+	return n, err
+}
+
+func (s *instrumentedSource) Close() error {
+	if c, ok := s.src.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// DefaultLatencyBuckets are the upper bounds used by ExpvarCollector when no
+// buckets are given.
+var DefaultLatencyBuckets = []time.Duration{
+	time.Microsecond,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+}
+
+// ExpvarCollector is a MetricsCollector backed by an expvar.Map with the keys
+// "reads", "bytes", "errors" and one cumulative "latency_le_<bound>" counter
+// per bucket plus "latency_le_inf".
+type ExpvarCollector struct {
+	vars    *expvar.Map
+	buckets []time.Duration
+	keys    []string
+}
+
+// NewExpvarCollector returns a collector whose counters are exposed under
+// name on the expvar endpoint. Like expvar.Publish, it panics if name is
+// already registered. If buckets is empty, DefaultLatencyBuckets is used;
+// buckets must be sorted in increasing order.
+func NewExpvarCollector(name string, buckets ...time.Duration) *ExpvarCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	c := &ExpvarCollector{
+		vars:    expvar.NewMap(name),
+		buckets: append([]time.Duration(nil), buckets...),
+	}
+	for _, b := range c.buckets {
+		c.keys = append(c.keys, "latency_le_"+b.String())
+	}
+	c.keys = append(c.keys, "latency_le_inf")
+	return c
+}
+
+// ObserveRead implements MetricsCollector.
+func (c *ExpvarCollector) ObserveRead(n int, err error, latency time.Duration) {
+	c.vars.Add("reads", 1)
+	c.vars.Add("bytes", int64(n))
+	if err != nil {
+		c.vars.Add("errors", 1)
+	}
+	for i, b := range c.buckets {
+		if latency <= b {
+			c.vars.Add(c.keys[i], 1)
+		}
+	}
+	c.vars.Add(c.keys[len(c.keys)-1], 1)
+}
+
+// Map returns the underlying expvar.Map.
+func (c *ExpvarCollector) Map() *expvar.Map {
+	return c.vars
+}
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"errors"
+	"expvar"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type recordingCollector struct {
+	n       []int
+	errs    []error
+	latency []time.Duration
+}
+
+func (c *recordingCollector) ObserveRead(n int, err error, latency time.Duration) {
+	c.n = append(c.n, n)
+	c.errs = append(c.errs, err)
+	c.latency = append(c.latency, latency)
+}
+
+func TestInstrumentedSourceObservesReads(t *testing.T) {
+	col := &recordingCollector{}
+	src, err := InstrumentedSource(testutil.NewSeqReader([]byte{1}), col)
+	if err != nil {
+		t.Fatalf("InstrumentedSource error: %v", err)
+	}
+	is := src.(*instrumentedSource)
+	tick := time.Unix(0, 0)
+	is.now = func() time.Time {
+		tick = tick.Add(5 * time.Millisecond)
+		return tick
+	}
+	if _, err := src.Read(make([]byte, 4)); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	if _, err := src.Read(nil); err != nil {
+		t.Fatalf("empty Read error: %v", err)
+	}
+	if len(col.n) != 1 || col.n[0] != 4 || col.errs[0] != nil || col.latency[0] != 5*time.Millisecond {
+		t.Fatalf("observations n=%v errs=%v latency=%v", col.n, col.errs, col.latency)
+	}
+
+	failing, err := InstrumentedSource(testutil.ErrReader{Err: io.ErrUnexpectedEOF}, col)
+	if err != nil {
+		t.Fatalf("InstrumentedSource error: %v", err)
+	}
+	if _, err := failing.Read(make([]byte, 1)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Read err=%v want io.ErrUnexpectedEOF", err)
+	}
+	if !errors.Is(col.errs[1], io.ErrUnexpectedEOF) {
+		t.Fatalf("observed err=%v want io.ErrUnexpectedEOF", col.errs[1])
+	}
+}
+
+func TestInstrumentedSourceNilArgs(t *testing.T) {
+	if _, err := InstrumentedSource(nil, &recordingCollector{}); !errors.Is(err, core.ErrNilSource) {
+		t.Fatalf("nil src err=%v want core.ErrNilSource", err)
+	}
+	src := testutil.NewSeqReader([]byte{1})
+	if got, err := InstrumentedSource(src, nil); err != nil || got != src {
+		t.Fatalf("nil collector should return src unchanged, got %v, %v", got, err)
+	}
+}
+
+func TestExpvarCollector(t *testing.T) {
+	col := NewExpvarCollector("randutil_test_instrumented", time.Millisecond, time.Second)
+	col.ObserveRead(8, nil, 500*time.Microsecond)
+	col.ObserveRead(0, io.EOF, 2*time.Second)
+	want := map[string]int64{
+		"reads":          2,
+		"bytes":          8,
+		"errors":         1,
+		"latency_le_1ms": 1,
+		"latency_le_1s":  1,
+		"latency_le_inf": 2,
+	}
+	for key, v := range want {
+		got, ok := col.Map().Get(key).(*expvar.Int)
+		if !ok || got.Value() != v {
+			t.Fatalf("%s=%v want %d", key, col.Map().Get(key), v)
+		}
+	}
+	if expvar.Get("randutil_test_instrumented") == nil {
+		t.Fatalf("collector not published")
+	}
+}
@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+const (
+	jitterDomain = "randutil jitter v1"
+	// jitterSamples is the number of timing deltas hashed into each 32-byte
+	// block. Assuming at least one bit of entropy per 4 deltas keeps every
+	// block fully seeded.
+	jitterSamples = 1024
+	jitterMemSize = 4096
+)
+
+type jitterSource struct {
+	mu     sync.Mutex
+	mem    [jitterMemSize]byte
+	pos    uint64
+	block  [sha256.Size]byte
+	off    int
+	now    func() time.Time
+	closed bool
+}
+
+// JitterSource returns an auxiliary entropy source that harvests CPU timing
+// jitter from memory accesses, in the spirit of haveged and jitterentropy.
+// It is slow and its quality depends on the platform's timer, so use it as
+// one input to MixSources on systems whose OS RNG is questionable (embedded
+// devices, early boot) rather than on its own.
+//
+// Each block is checked for timer variation; it returns
+// core.ErrInsufficientJitter at construction or from Read if the timer is too
+// coarse to yield jitter.
+func JitterSource() (core.Source, error) {
+	j := &jitterSource{now: time.Now, off: sha256.Size}
+	if err := j.refill(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *jitterSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.closed {
+		return 0, core.ErrSourceClosed
+	}
+	n := 0
+	for n < len(p) {
+		if j.off == len(j.block) {
+			if err := j.refill(); err != nil {
+				core.Zero(p)
+				return 0, err
+			}
+		}
+		c := copy(p[n:], j.block[j.off:])
+		core.Zero(j.block[j.off : j.off+c])
+		j.off += c
+		n += c
+	}
+	return n, nil
+}
+
+// refill hashes jitterSamples timing deltas of a memory-walking workload into
+// a fresh output block.
+func (j *jitterSource) refill() error {
+	h := sha256.New()
+	h.Write([]byte(jitterDomain))
+	var buf [8]byte
+	var prev, distinct int64
+	for i := 0; i < jitterSamples; i++ {
+		start := j.now()
+		j.touch()
+		delta := j.now().Sub(start).Nanoseconds()
+		if i > 0 && delta != prev {
+			distinct++
+		}
+		prev = delta
+		// #nosec G115 -- bit pattern of the delta is hashed, sign is irrelevant.
+		binary.LittleEndian.PutUint64(buf[:], uint64(delta))
+		h.Write(buf[:])
+		j.pos += uint64(buf[0]) | 1
+	}
+	if distinct < jitterSamples/4 {
+		return core.ErrInsufficientJitter
+	}
+	h.Sum(j.block[:0])
+	j.off = 0
+	return nil
+}
+
+// touch performs data-dependent memory accesses whose latency varies with
+// cache and pipeline state.
+func (j *jitterSource) touch() {
+	for k := 0; k < 64; k++ {
+		j.pos = j.pos*6364136223846793005 + 1442695040888963407
+		idx := (j.pos >> 52) % jitterMemSize
+		j.mem[idx] += byte(j.pos >> 32)
+	}
+}
+
+func (j *jitterSource) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.closed = true
+	core.Zero(j.block[:])
+	j.off = len(j.block)
+	return nil
+}
@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestJitterSource(t *testing.T) {
+	src, err := JitterSource()
+	if errors.Is(err, core.ErrInsufficientJitter) {
+		t.Skip("timer too coarse for jitter collection")
+	}
+	if err != nil {
+		t.Fatalf("JitterSource error: %v", err)
+	}
+	a := make([]byte, 48)
+	b := make([]byte, 48)
+	if _, err := io.ReadFull(src, a); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	if _, err := io.ReadFull(src, b); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	if bytes.Equal(a, b) {
+		t.Fatalf("consecutive reads produced identical output")
+	}
+	mixed, err := MixSources(CryptoSource(), src)
+	if err != nil {
+		t.Fatalf("MixSources error: %v", err)
+	}
+	if _, err := mixed.Read(make([]byte, 16)); err != nil {
+		t.Fatalf("mixed Read error: %v", err)
+	}
+}
+
+func TestJitterSourceRejectsFrozenClock(t *testing.T) {
+	frozen := time.Unix(0, 0)
+	j := &jitterSource{now: func() time.Time { return frozen }, off: 32}
+	if _, err := j.Read(make([]byte, 1)); !errors.Is(err, core.ErrInsufficientJitter) {
+		t.Fatalf("Read err=%v want ErrInsufficientJitter", err)
+	}
+}
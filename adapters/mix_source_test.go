@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestMixSourcesMatchesDefinition(t *testing.T) {
+	a := bytes.Repeat([]byte{0xaa}, 64)
+	b := bytes.Repeat([]byte{0x55}, 64)
+	src, err := MixSources(bytes.NewReader(a), bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("MixSources error: %v", err)
+	}
+	got := make([]byte, 40)
+	if _, err := io.ReadFull(src, got[:10]); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	if _, err := io.ReadFull(src, got[10:]); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	var want []byte
+	for i := uint64(0); i < 2; i++ {
+		var ctr [8]byte
+		binary.BigEndian.PutUint64(ctr[:], i)
+		h := sha256.New()
+		h.Write([]byte(mixDomain))
+		h.Write(ctr[:])
+		h.Write(a[:32])
+		h.Write(b[:32])
+		want = h.Sum(want)
+	}
+	if !bytes.Equal(got, want[:40]) {
+		t.Fatalf("output=%x want %x", got, want[:40])
+	}
+}
+
+func TestMixSourcesDependsOnEveryInput(t *testing.T) {
+	read := func(inputs ...[]byte) []byte {
+		var readers []io.Reader
+		for _, in := range inputs {
+			readers = append(readers, bytes.NewReader(in))
+		}
+		src, err := MixSources(readers...)
+		if err != nil {
+			t.Fatalf("MixSources error: %v", err)
+		}
+		out := make([]byte, 32)
+		if _, err := io.ReadFull(src, out); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		return out
+	}
+	zero := make([]byte, 32)
+	one := bytes.Repeat([]byte{1}, 32)
+	base := read(zero, zero)
+	if bytes.Equal(base, read(one, zero)) || bytes.Equal(base, read(zero, one)) {
+		t.Fatalf("changing one input did not change the output")
+	}
+}
+
+func TestMixSourcesErrors(t *testing.T) {
+	if _, err := MixSources(); !errors.Is(err, core.ErrNilSource) {
+		t.Fatalf("no sources err=%v want ErrNilSource", err)
+	}
+	if _, err := MixSources(testutil.NewSeqReader(), nil); !errors.Is(err, core.ErrNilSource) {
+		t.Fatalf("nil source err=%v want ErrNilSource", err)
+	}
+	src, err := MixSources(testutil.NewSeqReader([]byte{1}), testutil.ErrReader{Err: io.ErrUnexpectedEOF})
+	if err != nil {
+		t.Fatalf("MixSources error: %v", err)
+	}
+	if _, err := src.Read(make([]byte, 8)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Read err=%v want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestMixSourcesClose(t *testing.T) {
+	tracked := &closeRecorder{Reader: testutil.NewSeqReader([]byte{1})}
+	src, err := MixSources(tracked, testutil.NewSeqReader([]byte{2}))
+	if err != nil {
+		t.Fatalf("MixSources error: %v", err)
+	}
+	if err := src.(io.Closer).Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if !tracked.closed {
+		t.Fatalf("input not closed")
+	}
+	if _, err := src.Read(make([]byte, 1)); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("Read after Close err=%v want ErrSourceClosed", err)
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
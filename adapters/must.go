@@ -23,6 +23,25 @@ func MustDeterministicSourceWithLabel(seed []byte, label string) core.Source {
 	return src
 }
 
+// MustDeterministicSourceWithReseed returns a re-keying deterministic source or
+// panics.
+func MustDeterministicSourceWithReseed(seed []byte, interval ReseedInterval) core.Source {
+	src, err := DeterministicSourceWithReseed(seed, interval)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
+
+// MustPassphraseSource returns a passphrase-keyed source or panics.
+func MustPassphraseSource(passphrase string, salt []byte) core.Source {
+	src, err := PassphraseSource(passphrase, salt)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
+
 // MustDeriveSource returns a derived source or panics.
 func MustDeriveSource(seed []byte, label string) core.Source {
 	src, err := DeriveSource(seed, label)
@@ -67,3 +86,49 @@ func MustFastRNG() core.RNG {
 	}
 	return rng
 }
+
+// MustPCG64Source returns a non-cryptographic PCG64 source or panics.
+func MustPCG64Source(seed uint64) core.Source {
+	src, err := PCG64Source(seed)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
+
+// MustXoshiro256Source returns a non-cryptographic xoshiro256** source or
+// panics.
+func MustXoshiro256Source(seed uint64) core.Source {
+	src, err := Xoshiro256Source(seed)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
+
+// MustCTRDRBG returns an SP 800-90A CTR_DRBG or panics.
+func MustCTRDRBG(seed []byte, personalization []byte) *DRBG {
+	d, err := CTRDRBG(seed, personalization)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// MustHashDRBG returns an SP 800-90A Hash_DRBG or panics.
+func MustHashDRBG(seed []byte, personalization []byte) *DRBG {
+	d, err := HashDRBG(seed, personalization)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// MustDeriveChildSource returns a child source derived from parent or panics.
+func MustDeriveChildSource(parent core.Source, info string) core.Source {
+	src, err := DeriveChildSource(parent, info)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
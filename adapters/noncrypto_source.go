@@ -0,0 +1,69 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"math/bits"
+	"math/rand/v2"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// PCG64Source returns a fast, reproducible, NON-CRYPTOGRAPHIC stream from the
+// PCG-DXSM generator of math/rand/v2, seeded from seed via SplitMix64.
+//
+// WARNING: This is predictable from a small amount of output. NEVER USE FOR
+// TOKENS / AUTH / KEYS. Intended for simulations and benchmarks that need
+// speed and reproducibility but not security.
+//
+// Returns an error when policy mode disables deterministic sources.
+func PCG64Source(seed uint64) (core.Source, error) {
+	sm := splitMix64(seed)
+	pcg := rand.NewPCG(sm.next(), sm.next())
+	return &wordSource{next: pcg.Uint64}, nil
+}
+
+// Xoshiro256Source returns a fast, reproducible, NON-CRYPTOGRAPHIC stream from
+// the xoshiro256** generator, seeded from seed via SplitMix64.
+//
+// WARNING: This is predictable from a small amount of output. NEVER USE FOR
+// TOKENS / AUTH / KEYS. Intended for simulations and benchmarks that need
+// speed and reproducibility but not security.
+//
+// Returns an error when policy mode disables deterministic sources.
+func Xoshiro256Source(seed uint64) (core.Source, error) {
+	sm := splitMix64(seed)
+	x := &xoshiro256{}
+	for i := range x.s {
+		x.s[i] = sm.next()
+	}
+	return &wordSource{next: x.next}, nil
+}
+
+type splitMix64 uint64
+
+func (s *splitMix64) next() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+type xoshiro256 struct {
+	s [4]uint64
+}
+
+func (x *xoshiro256) next() uint64 {
+	s := &x.s
+	result := bits.RotateLeft64(s[1]*5, 7) * 9
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft64(s[3], 45)
+	return result
+}
@@ -0,0 +1,16 @@
+//go:build randutil_policy
+// +build randutil_policy
+
+package adapters
+
+import "github.com/aatuh/randutil/v2/core"
+
+// PCG64Source returns an error when policy mode is enabled.
+func PCG64Source(_ uint64) (core.Source, error) {
+	return nil, core.ErrDeterministicDisabled
+}
+
+// Xoshiro256Source returns an error when policy mode is enabled.
+func Xoshiro256Source(_ uint64) (core.Source, error) {
+	return nil, core.ErrDeterministicDisabled
+}
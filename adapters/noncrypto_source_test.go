@@ -0,0 +1,75 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestPCG64SourceMatchesMathRand(t *testing.T) {
+	src := mustNonCryptoSource(t, PCG64Source, 42)
+	sm := splitMix64(42)
+	want := rand.NewPCG(sm.next(), sm.next())
+	buf := make([]byte, 8)
+	for i := 0; i < 4; i++ {
+		if _, err := io.ReadFull(src, buf); err != nil {
+			t.Fatalf("ReadFull error: %v", err)
+		}
+		if got := binary.LittleEndian.Uint64(buf); got != want.Uint64() {
+			t.Fatalf("word %d mismatch", i)
+		}
+	}
+}
+
+func TestXoshiro256SourceGolden(t *testing.T) {
+	src := mustNonCryptoSource(t, Xoshiro256Source, 0)
+	buf := make([]byte, 8)
+	if _, err := io.ReadFull(src, buf); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	// First xoshiro256** output for a SplitMix64(0)-seeded state.
+	const want = uint64(0x99ec5f36cb75f2b4)
+	if got := binary.LittleEndian.Uint64(buf); got != want {
+		t.Fatalf("golden mismatch: %#x want %#x", got, want)
+	}
+}
+
+func TestNonCryptoSourcesIgnoreChunking(t *testing.T) {
+	for name, ctor := range map[string]func(uint64) (core.Source, error){
+		"pcg64":      PCG64Source,
+		"xoshiro256": Xoshiro256Source,
+	} {
+		whole := make([]byte, 37)
+		if _, err := io.ReadFull(mustNonCryptoSource(t, ctor, 7), whole); err != nil {
+			t.Fatalf("%s ReadFull error: %v", name, err)
+		}
+		chunked := mustNonCryptoSource(t, ctor, 7)
+		var got []byte
+		for _, n := range []int{3, 1, 9, 16, 8} {
+			part := make([]byte, n)
+			if _, err := io.ReadFull(chunked, part); err != nil {
+				t.Fatalf("%s ReadFull error: %v", name, err)
+			}
+			got = append(got, part...)
+		}
+		if !bytes.Equal(got, whole) {
+			t.Fatalf("%s output depends on read chunking", name)
+		}
+	}
+}
+
+func mustNonCryptoSource(t testing.TB, ctor func(uint64) (core.Source, error), seed uint64) core.Source {
+	t.Helper()
+	src, err := ctor(seed)
+	if err != nil {
+		t.Fatalf("source constructor error: %v", err)
+	}
+	return src
+}
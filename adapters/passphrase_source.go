@@ -0,0 +1,39 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"golang.org/x/crypto/argon2"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Argon2id parameters follow the second recommended option of RFC 9106.
+const (
+	passphraseTime    = 3
+	passphraseMemory  = 64 * 1024
+	passphraseThreads = 4
+	passphraseKeyLen  = 32
+	minPassphraseSalt = 8
+)
+
+// PassphraseSource returns a reproducible stream keyed by stretching
+// passphrase and salt through Argon2id, so simulation seeds can be
+// human-readable. Each call costs about 64 MiB of memory and noticeable CPU
+// time by design.
+//
+// WARNING: This is deterministic. DO NOT USE FOR TOKENS / AUTH unless the
+// passphrase is high-entropy and kept secret. Intended for tests, benchmarks,
+// and replayable simulations.
+//
+// Returns core.ErrSeedTooShort if passphrase is empty or salt is shorter than
+// 8 bytes, and an error when policy mode disables deterministic sources.
+func PassphraseSource(passphrase string, salt []byte) (core.Source, error) {
+	if passphrase == "" || len(salt) < minPassphraseSalt {
+		return nil, core.ErrSeedTooShort
+	}
+	key := argon2.IDKey([]byte(passphrase), salt, passphraseTime, passphraseMemory, passphraseThreads, passphraseKeyLen)
+	defer core.Zero(key)
+	return deterministicSourceFromSeed(key)
+}
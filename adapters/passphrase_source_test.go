@@ -0,0 +1,54 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestPassphraseSource(t *testing.T) {
+	salt := []byte("randutil-salt")
+	read := func(pass string, salt []byte) []byte {
+		src, err := PassphraseSource(pass, salt)
+		if err != nil {
+			t.Fatalf("PassphraseSource error: %v", err)
+		}
+		out := make([]byte, 32)
+		if _, err := io.ReadFull(src, out); err != nil {
+			t.Fatalf("ReadFull error: %v", err)
+		}
+		return out
+	}
+	got := read("correct horse battery staple", salt)
+	key := argon2.IDKey([]byte("correct horse battery staple"), salt, 3, 64*1024, 4, 32)
+	want := make([]byte, 32)
+	if _, err := io.ReadFull(mustDeterministicSource(t, key), want); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("output=%x want %x", got, want)
+	}
+	if bytes.Equal(got, read("correct horse battery stapler", salt)) {
+		t.Fatalf("different passphrases produced identical output")
+	}
+	if bytes.Equal(got, read("correct horse battery staple", []byte("other-salt"))) {
+		t.Fatalf("different salts produced identical output")
+	}
+}
+
+func TestPassphraseSourceRejectsWeakInput(t *testing.T) {
+	if _, err := PassphraseSource("", []byte("12345678")); !errors.Is(err, core.ErrSeedTooShort) {
+		t.Fatalf("empty passphrase err=%v want ErrSeedTooShort", err)
+	}
+	if _, err := PassphraseSource("pass", []byte("short")); !errors.Is(err, core.ErrSeedTooShort) {
+		t.Fatalf("short salt err=%v want ErrSeedTooShort", err)
+	}
+}
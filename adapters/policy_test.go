@@ -17,4 +17,19 @@ func TestPolicyDisablesDeterministicSources(t *testing.T) {
 	if _, err := DeterministicSourceWithLabel([]byte("seed"), "label"); !errors.Is(err, core.ErrDeterministicDisabled) {
 		t.Fatalf("DeterministicSourceWithLabel error = %v, want ErrDeterministicDisabled", err)
 	}
+	if _, err := DeterministicSourceWithReseed([]byte("seed"), ReseedInterval{Bytes: 1}); !errors.Is(err, core.ErrDeterministicDisabled) {
+		t.Fatalf("DeterministicSourceWithReseed error = %v, want ErrDeterministicDisabled", err)
+	}
+	if _, err := PassphraseSource("pass", []byte("saltsalt")); !errors.Is(err, core.ErrDeterministicDisabled) {
+		t.Fatalf("PassphraseSource error = %v, want ErrDeterministicDisabled", err)
+	}
+	if _, err := StreamPool([]byte("seed"), 1); !errors.Is(err, core.ErrDeterministicDisabled) {
+		t.Fatalf("StreamPool error = %v, want ErrDeterministicDisabled", err)
+	}
+	if _, err := PCG64Source(1); !errors.Is(err, core.ErrDeterministicDisabled) {
+		t.Fatalf("PCG64Source error = %v, want ErrDeterministicDisabled", err)
+	}
+	if _, err := Xoshiro256Source(1); !errors.Is(err, core.ErrDeterministicDisabled) {
+		t.Fatalf("Xoshiro256Source error = %v, want ErrDeterministicDisabled", err)
+	}
 }
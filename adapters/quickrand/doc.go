@@ -0,0 +1,4 @@
+// Package quickrand connects randutil generators to testing/quick. It lives
+// in its own package because importing testing/quick registers the
+// -quickchecks flag, which production binaries should not inherit.
+package quickrand
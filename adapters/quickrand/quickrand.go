@@ -0,0 +1,60 @@
+package quickrand
+
+import (
+	"math/rand"
+	"testing/quick"
+
+	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/core"
+)
+
+type source struct {
+	rng core.RNG
+}
+
+// Rand returns a *rand.Rand driven by rng, as required by quick.Config.Rand.
+// math/rand cannot report errors, so draws panic if rng fails; Seed calls
+// are ignored. If rng is nil, it returns nil.
+func Rand(rng core.RNG) *rand.Rand {
+	if rng == nil {
+		return nil
+	}
+	// #nosec G404 -- the values come from rng, not from math/rand's generator.
+	return rand.New(source{rng: rng})
+}
+
+// Config returns a quick.Config whose Rand is driven by rng. maxCount <= 0
+// keeps the testing/quick default.
+func Config(rng core.RNG, maxCount int) *quick.Config {
+	cfg := &quick.Config{Rand: Rand(rng)}
+	if maxCount > 0 {
+		cfg.MaxCount = maxCount
+	}
+	return cfg
+}
+
+// RNG returns a core.RNG that draws from r. Use it inside a quick.Generator's
+// Generate method so randutil subpackages (randstring, email, uuid, ...)
+// produce values from the randomness testing/quick supplies. If r is nil, it
+// returns nil.
+func RNG(r *rand.Rand) core.RNG {
+	if r == nil {
+		return nil
+	}
+	return core.New(adapters.FromRandV2(r))
+}
+
+func (s source) Int63() int64 {
+	// #nosec G115 -- the shift leaves 63 bits, which fit in int64.
+	return int64(s.Uint64() >> 1)
+}
+
+func (s source) Uint64() uint64 {
+	u, err := s.rng.Uint64()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func (s source) Seed(int64) {}
@@ -0,0 +1,48 @@
+package quickrand
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/randstring"
+)
+
+type token string
+
+func (token) Generate(r *rand.Rand, size int) reflect.Value {
+	s, err := randstring.New(RNG(r)).String(1 + size%16)
+	if err != nil {
+		panic(err)
+	}
+	return reflect.ValueOf(token(s))
+}
+
+func TestConfigDrivesQuickCheck(t *testing.T) {
+	prop := func(tok token, n uint8) bool {
+		return len(tok) >= 1 && len(tok) <= 16
+	}
+	if err := quick.Check(prop, Config(core.New(adapters.CryptoSource()), 50)); err != nil {
+		t.Fatalf("quick.Check error: %v", err)
+	}
+}
+
+func TestRandIsReproducibleFromSeededRNG(t *testing.T) {
+	draw := func() []int64 {
+		r := Rand(core.New(adapters.FromRandV2(rand.New(rand.NewSource(7)))))
+		return []int64{r.Int63(), r.Int63(), r.Int63()}
+	}
+	a, b := draw(), draw()
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("draws differ: %v vs %v", a, b)
+	}
+	if Rand(nil) != nil || RNG(nil) != nil {
+		t.Fatalf("nil inputs should return nil")
+	}
+	if cfg := Config(core.New(adapters.CryptoSource()), 0); cfg.MaxCount != 0 {
+		t.Fatalf("MaxCount=%d want default 0", cfg.MaxCount)
+	}
+}
@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"math/rand/v2"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+type randV2Source struct {
+	rng core.RNG
+}
+
+// AsRandV2Source adapts rng to math/rand/v2's Source, so randutil generators
+// can drive rand.New, rand.Shuffle and other code built on that interface.
+// rand.Source cannot report errors, so Uint64 panics if rng fails; wrap only
+// sources that cannot fail in practice, such as CryptoSource. If rng is nil,
+// it returns nil.
+func AsRandV2Source(rng core.RNG) rand.Source {
+	if rng == nil {
+		return nil
+	}
+	return randV2Source{rng: rng}
+}
+
+func (s randV2Source) Uint64() uint64 {
+	u, err := s.rng.Uint64()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// FromRandV2 adapts a math/rand/v2 Source to core.Source. Each Uint64 is
+// emitted little-endian and leftover bytes are kept for the next Read, so
+// the stream does not depend on read sizes. The result is only as strong as
+// src; math/rand/v2 generators other than ChaCha8 are NOT cryptographically
+// secure. If src is nil, it returns nil.
+func FromRandV2(src rand.Source) core.Source {
+	if src == nil {
+		return nil
+	}
+	return &wordSource{next: src.Uint64}
+}
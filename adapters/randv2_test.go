@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestAsRandV2Source(t *testing.T) {
+	src := AsRandV2Source(core.New(testutil.NewSeqReader(testutil.Uint64Bytes(42))))
+	if got := src.Uint64(); got != 42 {
+		t.Fatalf("Uint64=%d want 42", got)
+	}
+	r := rand.New(AsRandV2Source(core.New(CryptoSource())))
+	s := []int{1, 2, 3, 4}
+	r.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	if AsRandV2Source(nil) != nil {
+		t.Fatalf("nil rng should return nil")
+	}
+}
+
+func TestAsRandV2SourcePanicsOnError(t *testing.T) {
+	src := AsRandV2Source(core.New(testutil.ErrReader{Err: io.ErrUnexpectedEOF}))
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Fatalf("recovered %v want io.ErrUnexpectedEOF", r)
+		}
+	}()
+	src.Uint64()
+}
+
+func TestFromRandV2RoundTrip(t *testing.T) {
+	want := rand.NewPCG(1, 2)
+	src := FromRandV2(rand.NewPCG(1, 2))
+	buf := make([]byte, 20)
+	if _, err := io.ReadFull(src, buf[:3]); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	if _, err := io.ReadFull(src, buf[3:]); err != nil {
+		t.Fatalf("ReadFull error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if got, w := binary.LittleEndian.Uint64(buf[i*8:]), want.Uint64(); got != w {
+			t.Fatalf("word %d=%#x want %#x", i, got, w)
+		}
+	}
+	back := AsRandV2Source(core.New(FromRandV2(rand.NewPCG(3, 4))))
+	ref := rand.NewPCG(3, 4)
+	for i := 0; i < 4; i++ {
+		if got, w := back.Uint64(), ref.Uint64(); got != w {
+			t.Fatalf("round trip %d=%#x want %#x", i, got, w)
+		}
+	}
+	if FromRandV2(nil) != nil {
+		t.Fatalf("nil src should return nil")
+	}
+}
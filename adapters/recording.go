@@ -1,6 +1,8 @@
 package adapters
 
 import (
+	"encoding/binary"
+	"hash/crc32"
 	"io"
 	"sync"
 
@@ -23,6 +25,12 @@ func NewRecorder(src core.Source) *Recorder {
 	return &Recorder{src: src}
 }
 
+// RecordingSource is an alias for NewRecorder that reads naturally next to
+// ReplaySource.
+func RecordingSource(src core.Source) *Recorder {
+	return NewRecorder(src)
+}
+
 // Read reads from the underlying source and appends the bytes to the record.
 func (r *Recorder) Read(p []byte) (int, error) {
 	if len(p) == 0 {
@@ -71,6 +79,24 @@ func (r *Recorder) Replay() core.Source {
 	return ReplaySource(r.Bytes())
 }
 
+// MarshalBinary serializes the recorded bytes in a versioned, checksummed
+// format that ParseRecording and ReadReplaySource accept.
+func (r *Recorder) MarshalBinary() ([]byte, error) {
+	data := r.Bytes()
+	defer core.Zero(data)
+	return MarshalRecording(data), nil
+}
+
+// WriteRecording writes the serialized recording to w. It is deliberately
+// not named WriteTo: a Recorder is an io.Reader of source bytes, and
+// io.Copy would otherwise serialize the recording instead of streaming.
+func (r *Recorder) WriteRecording(w io.Writer) (int64, error) {
+	b, _ := r.MarshalBinary()
+	defer core.Zero(b)
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
 // Close closes the underlying source if it is closable and zeroes the buffer.
 func (r *Recorder) Close() error {
 	if r == nil {
@@ -90,6 +116,64 @@ func (r *Recorder) Close() error {
 	return nil
 }
 
+const (
+	recordingMagic   = "RURC"
+	recordingVersion = 1
+	recordingHeader  = len(recordingMagic) + 1 + 8
+	recordingTrailer = 4
+)
+
+// MarshalRecording serializes data as a recording: a 4-byte magic, a version
+// byte, the little-endian data length, the data and a CRC-32 of the data.
+func MarshalRecording(data []byte) []byte {
+	out := make([]byte, 0, recordingHeader+len(data)+recordingTrailer)
+	out = append(out, recordingMagic...)
+	out = append(out, recordingVersion)
+	// #nosec G115 -- len is non-negative.
+	out = binary.LittleEndian.AppendUint64(out, uint64(len(data)))
+	out = append(out, data...)
+	return binary.LittleEndian.AppendUint32(out, crc32.ChecksumIEEE(data))
+}
+
+// ParseRecording returns the bytes stored in a recording produced by
+// MarshalRecording. It returns core.ErrInvalidRecording if the header,
+// length or checksum does not match.
+func ParseRecording(b []byte) ([]byte, error) {
+	if len(b) < recordingHeader+recordingTrailer ||
+		string(b[:len(recordingMagic)]) != recordingMagic ||
+		b[len(recordingMagic)] != recordingVersion {
+		return nil, core.ErrInvalidRecording
+	}
+	n := binary.LittleEndian.Uint64(b[len(recordingMagic)+1:])
+	// #nosec G115 -- len(b) is at least recordingHeader+recordingTrailer.
+	if n != uint64(len(b)-recordingHeader-recordingTrailer) {
+		return nil, core.ErrInvalidRecording
+	}
+	data := b[recordingHeader : len(b)-recordingTrailer]
+	if crc32.ChecksumIEEE(data) != binary.LittleEndian.Uint32(b[len(b)-recordingTrailer:]) {
+		return nil, core.ErrInvalidRecording
+	}
+	out := make([]byte, len(data))
+	copy(out, data)
+	return out, nil
+}
+
+// ReadReplaySource reads a serialized recording from r and returns a source
+// that replays it exactly.
+func ReadReplaySource(r io.Reader) (core.Source, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	defer core.Zero(b)
+	data, err := ParseRecording(b)
+	if err != nil {
+		return nil, err
+	}
+	defer core.Zero(data)
+	return ReplaySource(data), nil
+}
+
 type replaySource struct {
 	mu     sync.Mutex
 	data   []byte
@@ -71,3 +71,48 @@ func TestNilRecorderMethods(t *testing.T) {
 		t.Fatalf("nil recorder replay Read = (%d, %v), want (0, EOF)", n, err)
 	}
 }
+
+func TestRecordingSerializeReplay(t *testing.T) {
+	rec := RecordingSource(testutil.NewSeqReader([]byte{4, 5, 6, 7}))
+	gen := core.New(rec)
+	want, err := gen.Bytes(10)
+	if err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+	var buf bytes.Buffer
+	if _, err := rec.WriteRecording(&buf); err != nil {
+		t.Fatalf("WriteTo error: %v", err)
+	}
+	replay, err := ReadReplaySource(&buf)
+	if err != nil {
+		t.Fatalf("ReadReplaySource error: %v", err)
+	}
+	got, err := core.New(replay).Bytes(10)
+	if err != nil {
+		t.Fatalf("replay Bytes error: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("replay=%v want %v", got, want)
+	}
+}
+
+func TestParseRecordingRejectsCorruption(t *testing.T) {
+	good := MarshalRecording([]byte{1, 2, 3})
+	if data, err := ParseRecording(good); err != nil || !bytes.Equal(data, []byte{1, 2, 3}) {
+		t.Fatalf("ParseRecording=%v err=%v want [1 2 3] nil", data, err)
+	}
+	cases := map[string][]byte{
+		"short":    good[:5],
+		"magic":    append([]byte("XXXX"), good[4:]...),
+		"version":  append(append([]byte(nil), good[:4]...), append([]byte{9}, good[5:]...)...),
+		"truncate": good[:len(good)-1],
+	}
+	flipped := append([]byte(nil), good...)
+	flipped[recordingHeader] ^= 1
+	cases["checksum"] = flipped
+	for name, b := range cases {
+		if _, err := ParseRecording(b); !errors.Is(err, core.ErrInvalidRecording) {
+			t.Fatalf("%s: err=%v want ErrInvalidRecording", name, err)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package adapters
+
+import "time"
+
+// ReseedInterval controls when DeterministicSourceWithReseed re-keys. Either
+// field may be zero to disable that trigger, but not both.
+type ReseedInterval struct {
+	// Bytes re-keys after this many bytes have been served under one key.
+	Bytes uint64
+	// Period re-keys on the first Read after this much time has passed since
+	// the previous key was installed. Output then depends on read timing, so
+	// leave it zero when exact reproducibility matters.
+	Period time.Duration
+}
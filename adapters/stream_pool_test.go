@@ -0,0 +1,131 @@
+//go:build !randutil_policy
+// +build !randutil_policy
+
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestStreamPoolReproducibleAndIndependent(t *testing.T) {
+	seed := []byte("seed")
+	a, err := StreamPool(seed, 4)
+	if err != nil {
+		t.Fatalf("StreamPool error: %v", err)
+	}
+	b, err := StreamPool(seed, 8)
+	if err != nil {
+		t.Fatalf("StreamPool error: %v", err)
+	}
+	seen := map[string]bool{}
+	for i := 0; i < a.Len(); i++ {
+		sa, _ := a.Stream(i)
+		sb, _ := b.Stream(i)
+		x := make([]byte, 16)
+		y := make([]byte, 16)
+		if _, err := io.ReadFull(sa, x); err != nil {
+			t.Fatalf("ReadFull error: %v", err)
+		}
+		if _, err := io.ReadFull(sb, y); err != nil {
+			t.Fatalf("ReadFull error: %v", err)
+		}
+		if !bytes.Equal(x, y) {
+			t.Fatalf("stream %d differs between pools", i)
+		}
+		if seen[string(x)] {
+			t.Fatalf("stream %d duplicates another stream", i)
+		}
+		seen[string(x)] = true
+	}
+}
+
+func TestStreamPoolStreamFor(t *testing.T) {
+	a, err := StreamPool([]byte("seed"), 2)
+	if err != nil {
+		t.Fatalf("StreamPool error: %v", err)
+	}
+	b, err := StreamPool([]byte("seed"), 8)
+	if err != nil {
+		t.Fatalf("StreamPool error: %v", err)
+	}
+	names := []string{"TestA", "TestB", "TestA/sub", "TestC"}
+	want := map[string][]byte{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			src, err := a.StreamFor(name)
+			if err != nil {
+				t.Errorf("StreamFor error: %v", err)
+				return
+			}
+			buf := make([]byte, 16)
+			if _, err := io.ReadFull(src, buf); err != nil {
+				t.Errorf("ReadFull error: %v", err)
+				return
+			}
+			mu.Lock()
+			want[name] = buf
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+	// Read in a different order from a pool of a different size.
+	seen := map[string]bool{}
+	for i := len(names) - 1; i >= 0; i-- {
+		src, err := b.StreamFor(names[i])
+		if err != nil {
+			t.Fatalf("StreamFor error: %v", err)
+		}
+		got := make([]byte, 16)
+		if _, err := io.ReadFull(src, got); err != nil {
+			t.Fatalf("ReadFull error: %v", err)
+		}
+		if !bytes.Equal(got, want[names[i]]) {
+			t.Fatalf("StreamFor(%q) differs between pools", names[i])
+		}
+		if seen[string(got)] {
+			t.Fatalf("StreamFor(%q) duplicates another stream", names[i])
+		}
+		seen[string(got)] = true
+	}
+	first, _ := a.StreamFor("TestA")
+	if again, _ := a.StreamFor("TestA"); again != first {
+		t.Fatalf("StreamFor returned a new stream for a known name")
+	}
+	if _, err := a.Generator(0); err != nil {
+		t.Fatalf("Generator error: %v", err)
+	}
+	for _, p := range []*Streams{a, b} {
+		if err := p.Close(); err != nil {
+			t.Fatalf("Close error: %v", err)
+		}
+	}
+	if _, err := a.StreamFor("TestD"); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("StreamFor after Close err=%v want ErrSourceClosed", err)
+	}
+	if _, err := a.Stream(0); !errors.Is(err, core.ErrSourceClosed) {
+		t.Fatalf("Stream after Close err=%v want ErrSourceClosed", err)
+	}
+}
+
+func TestStreamPoolErrors(t *testing.T) {
+	if _, err := StreamPool([]byte("seed"), 0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("n=0 err=%v want ErrNonPositiveBound", err)
+	}
+	pool, err := StreamPool([]byte("seed"), 1)
+	if err != nil {
+		t.Fatalf("StreamPool error: %v", err)
+	}
+	if _, err := pool.Stream(1); !errors.Is(err, core.ErrResultOutOfRange) {
+		t.Fatalf("Stream(1) err=%v want ErrResultOutOfRange", err)
+	}
+}
@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// wordSource turns a 64-bit word generator into a byte stream. Words are
+// emitted little-endian and leftover bytes are kept for the next Read, so the
+// stream does not depend on how callers chunk their reads.
+type wordSource struct {
+	mu   sync.Mutex
+	next func() uint64
+	buf  [8]byte
+	pos  int
+}
+
+func (w *wordSource) Read(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n := 0
+	for n < len(p) && w.pos > 0 && w.pos < len(w.buf) {
+		p[n] = w.buf[w.pos]
+		w.pos++
+		n++
+	}
+	for len(p)-n >= 8 {
+		binary.LittleEndian.PutUint64(p[n:], w.next())
+		n += 8
+	}
+	if n < len(p) {
+		binary.LittleEndian.PutUint64(w.buf[:], w.next())
+		w.pos = copy(p[n:], w.buf[:])
+		n = len(p)
+	}
+	return n, nil
+}
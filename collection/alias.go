@@ -0,0 +1,152 @@
+package collection
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// WeightedSampler draws items with probability proportional to their
+// weights in O(1) per draw, using Vose's alias method. Building the tables
+// costs O(n), so it pays off when the same weights are used repeatedly.
+//
+// Concurrency: safe for concurrent use if the underlying RNG is safe; the
+// tables are read-only after construction.
+type WeightedSampler[T any] struct {
+	rng   rng
+	items []T
+	prob  []float64
+	alias []int
+}
+
+// NewWeightedSampler precomputes alias tables for items using the default
+// RNG. Zero-weight items are never drawn.
+//
+// Parameters:
+//   - items: Items to draw from; the slice is copied.
+//   - weights: Non-negative weights, one per item, with at least one > 0.
+//
+// Returns:
+//   - *WeightedSampler[T]: The sampler.
+//   - error: ErrEmptyItems, ErrWeightsMismatch or ErrInvalidWeights.
+func NewWeightedSampler[T any](items []T, weights []float64) (*WeightedSampler[T], error) {
+	return Default[T]().NewWeightedSampler(items, weights)
+}
+
+// NewWeightedSampler precomputes alias tables for items using g's RNG.
+func (g *Generator[T]) NewWeightedSampler(items []T, weights []float64) (*WeightedSampler[T], error) {
+	if len(items) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	if len(items) != len(weights) {
+		return nil, core.ErrWeightsMismatch
+	}
+	var sum float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, core.ErrInvalidWeights
+		}
+		sum += w
+	}
+	if sum <= 0 || math.IsInf(sum, 0) {
+		return nil, core.ErrInvalidWeights
+	}
+
+	n := len(weights)
+	prob := make([]float64, n)
+	alias := make([]int, n)
+	scaled := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		scaled[i] = w / sum * float64(n)
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+		prob[s] = scaled[s]
+		alias[s] = l
+		scaled[l] = (scaled[l] + scaled[s]) - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// Leftovers are 1 up to rounding error.
+	for _, i := range large {
+		prob[i] = 1
+		alias[i] = i
+	}
+	for _, i := range small {
+		if weights[i] > 0 {
+			prob[i] = 1
+			alias[i] = i
+			continue
+		}
+		// A zero-weight leftover must still never be drawn: point it at
+		// any positive-weight item.
+		for j, w := range weights {
+			if w > 0 {
+				prob[i] = 0
+				alias[i] = j
+				break
+			}
+		}
+	}
+
+	dup := make([]T, n)
+	copy(dup, items)
+	return &WeightedSampler[T]{
+		rng:   g.rngOrDefault(),
+		items: dup,
+		prob:  prob,
+		alias: alias,
+	}, nil
+}
+
+// Draw returns one item with probability proportional to its weight.
+func (s *WeightedSampler[T]) Draw() (T, error) {
+	i, err := s.rng.Intn(len(s.items))
+	if err != nil {
+		var z T
+		return z, err
+	}
+	u, err := s.rng.Float64()
+	if err != nil {
+		var z T
+		return z, err
+	}
+	if u < s.prob[i] {
+		return s.items[i], nil
+	}
+	return s.items[s.alias[i]], nil
+}
+
+// DrawN returns n independent draws (with replacement).
+func (s *WeightedSampler[T]) DrawN(n int) ([]T, error) {
+	if n < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	out := make([]T, n)
+	for i := range out {
+		v, err := s.Draw()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// Len returns the number of items the sampler was built with.
+func (s *WeightedSampler[T]) Len() int {
+	return len(s.items)
+}
@@ -0,0 +1,90 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestWeightedSamplerDistribution(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	weights := []float64{1, 0, 3, 6}
+	s, err := NewWeightedSampler(items, weights)
+	if err != nil {
+		t.Fatalf("NewWeightedSampler error: %v", err)
+	}
+	const n = 50000
+	got, err := s.DrawN(n)
+	if err != nil {
+		t.Fatalf("DrawN error: %v", err)
+	}
+	counts := map[string]int{}
+	for _, v := range got {
+		counts[v]++
+	}
+	if counts["b"] != 0 {
+		t.Fatalf("zero-weight item drawn %d times", counts["b"])
+	}
+	for i, it := range items {
+		want := weights[i] / 10
+		frac := float64(counts[it]) / n
+		if math.Abs(frac-want) > 0.02 {
+			t.Fatalf("item %s frac=%.3f want %.3f", it, frac, want)
+		}
+	}
+	if s.Len() != 4 {
+		t.Fatalf("Len=%d want 4", s.Len())
+	}
+}
+
+func TestWeightedSamplerZeroWeightsNeverDrawn(t *testing.T) {
+	// Many zero weights force zero-weight leftovers in the small list.
+	items := make([]int, 64)
+	weights := make([]float64, 64)
+	for i := range items {
+		items[i] = i
+	}
+	weights[7] = 1
+	s, err := NewWeightedSampler(items, weights)
+	if err != nil {
+		t.Fatalf("NewWeightedSampler error: %v", err)
+	}
+	for i := 0; i < 1000; i++ {
+		v, err := s.Draw()
+		if err != nil {
+			t.Fatalf("Draw error: %v", err)
+		}
+		if v != 7 {
+			t.Fatalf("Draw=%d want 7", v)
+		}
+	}
+}
+
+func TestWeightedSamplerErrors(t *testing.T) {
+	if _, err := NewWeightedSampler([]int{}, nil); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("err=%v want ErrEmptyItems", err)
+	}
+	if _, err := NewWeightedSampler([]int{1}, []float64{1, 2}); !errors.Is(err, core.ErrWeightsMismatch) {
+		t.Fatalf("err=%v want ErrWeightsMismatch", err)
+	}
+	if _, err := NewWeightedSampler([]int{1, 2}, []float64{0, 0}); !errors.Is(err, core.ErrInvalidWeights) {
+		t.Fatalf("err=%v want ErrInvalidWeights", err)
+	}
+	if _, err := NewWeightedSampler([]int{1}, []float64{math.Inf(1)}); !errors.Is(err, core.ErrInvalidWeights) {
+		t.Fatalf("err=%v want ErrInvalidWeights", err)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	s, err := g.NewWeightedSampler([]int{1, 2}, []float64{1, 1})
+	if err != nil {
+		t.Fatalf("NewWeightedSampler error: %v", err)
+	}
+	if _, err := s.Draw(); !errors.Is(err, errTestOf) {
+		t.Fatalf("Draw err=%v want %v", err, errTestOf)
+	}
+	if _, err := s.DrawN(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("DrawN err=%v want ErrNegativeLength", err)
+	}
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Cycler returns items in repeated random orders (a "shuffle bag"): every
+// item appears exactly once per cycle of len(items) draws, and each cycle
+// uses a fresh shuffle.
+//
+// Concurrency: safe for concurrent use if the underlying RNG is safe.
+type Cycler[T any] struct {
+	mu    sync.Mutex
+	rng   rng
+	items []T
+	pos   int
+}
+
+// NewCycler returns a Cycler over a copy of items using the default RNG.
+//
+// Parameters:
+//   - items: Items to cycle through.
+//
+// Returns:
+//   - *Cycler[T]: The cycler.
+//   - error: ErrEmptyItems if items is empty.
+func NewCycler[T any](items []T) (*Cycler[T], error) {
+	return Default[T]().NewCycler(items)
+}
+
+// NewCycler returns a Cycler over a copy of items using g's RNG.
+func (g *Generator[T]) NewCycler(items []T) (*Cycler[T], error) {
+	if len(items) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	dup := make([]T, len(items))
+	copy(dup, items)
+	// pos == len forces a shuffle on the first draw.
+	return &Cycler[T]{rng: g.rngOrDefault(), items: dup, pos: len(dup)}, nil
+}
+
+// Next returns the next item, reshuffling at the start of each cycle.
+func (c *Cycler[T]) Next() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pos == len(c.items) {
+		if err := shuffleWithRNG(c.rng, c.items); err != nil {
+			var z T
+			return z, err
+		}
+		c.pos = 0
+	}
+	item := c.items[c.pos]
+	c.pos++
+	return item, nil
+}
+
+// All returns an endless iterator over Next. If a draw fails, the iterator
+// yields the error and stops.
+func (c *Cycler[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			item, err := c.Next()
+			if err != nil {
+				yield(item, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the cycle length.
+func (c *Cycler[T]) Len() int {
+	return len(c.items)
+}
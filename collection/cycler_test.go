@@ -0,0 +1,71 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestCyclerEachItemOncePerCycle(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	c, err := NewCycler(items)
+	if err != nil {
+		t.Fatalf("NewCycler error: %v", err)
+	}
+	for cycle := 0; cycle < 20; cycle++ {
+		seen := map[string]bool{}
+		for i := 0; i < c.Len(); i++ {
+			v, err := c.Next()
+			if err != nil {
+				t.Fatalf("Next error: %v", err)
+			}
+			if seen[v] {
+				t.Fatalf("cycle %d repeated %q", cycle, v)
+			}
+			seen[v] = true
+		}
+		if len(seen) != len(items) {
+			t.Fatalf("cycle %d saw %d items", cycle, len(seen))
+		}
+	}
+}
+
+func TestCyclerAll(t *testing.T) {
+	c, err := NewCycler([]int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("NewCycler error: %v", err)
+	}
+	n := 0
+	sum := 0
+	for v, err := range c.All() {
+		if err != nil {
+			t.Fatalf("All error: %v", err)
+		}
+		sum += v
+		n++
+		if n == 6 {
+			break
+		}
+	}
+	if sum != 12 {
+		t.Fatalf("two cycles summed to %d, want 12", sum)
+	}
+}
+
+func TestCyclerErrors(t *testing.T) {
+	if _, err := NewCycler([]int{}); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("err=%v want ErrEmptyItems", err)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	c, err := g.NewCycler([]int{1, 2})
+	if err != nil {
+		t.Fatalf("NewCycler error: %v", err)
+	}
+	for _, err := range c.All() {
+		if !errors.Is(err, errTestOf) {
+			t.Fatalf("err=%v want %v", err, errTestOf)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package collection
+
+import "github.com/aatuh/randutil/v2/core"
+
+// MapEntry is a key/value pair drawn from a map.
+type MapEntry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// PickMapKey returns a uniformly random key of m without copying the map.
+//
+// Parameters:
+//   - m: Map to pick from.
+//
+// Returns:
+//   - K: The chosen key.
+//   - error: ErrEmptyItems if m is empty, or an RNG error.
+func PickMapKey[K comparable, V any](m map[K]V) (K, error) {
+	e, err := pickMapEntryWithRNG(defaultRNG, m)
+	return e.Key, err
+}
+
+// PickMapValue returns the value of a uniformly random key of m.
+//
+// Parameters:
+//   - m: Map to pick from.
+//
+// Returns:
+//   - V: The chosen value.
+//   - error: ErrEmptyItems if m is empty, or an RNG error.
+func PickMapValue[K comparable, V any](m map[K]V) (V, error) {
+	e, err := pickMapEntryWithRNG(defaultRNG, m)
+	return e.Value, err
+}
+
+// SampleMapEntries returns k distinct entries of m uniformly at random, in
+// random order, in a single pass over the map.
+//
+// Parameters:
+//   - m: Map to sample from.
+//   - k: Number of entries to draw.
+//
+// Returns:
+//   - []MapEntry[K, V]: The sampled entries.
+//   - error: ErrNegativeLength, ErrSampleTooLarge, or an RNG error.
+func SampleMapEntries[K comparable, V any](m map[K]V, k int) ([]MapEntry[K, V], error) {
+	return sampleMapEntriesWithRNG(defaultRNG, m, k)
+}
+
+// PickMapKeyOf is PickMapKey using g's RNG.
+func PickMapKeyOf[K comparable, V, E any](g *Generator[E], m map[K]V) (K, error) {
+	e, err := pickMapEntryWithRNG(g.rngOrDefault(), m)
+	return e.Key, err
+}
+
+// PickMapValueOf is PickMapValue using g's RNG.
+func PickMapValueOf[K comparable, V, E any](g *Generator[E], m map[K]V) (V, error) {
+	e, err := pickMapEntryWithRNG(g.rngOrDefault(), m)
+	return e.Value, err
+}
+
+// SampleMapEntriesOf is SampleMapEntries using g's RNG.
+func SampleMapEntriesOf[K comparable, V, E any](
+	g *Generator[E], m map[K]V, k int,
+) ([]MapEntry[K, V], error) {
+	return sampleMapEntriesWithRNG(g.rngOrDefault(), m, k)
+}
+
+// pickMapEntryWithRNG draws an index and walks the map to it. Go's map
+// iteration order is randomised but not uniform, so it cannot be used as
+// the source of randomness on its own.
+func pickMapEntryWithRNG[K comparable, V any](rng rng, m map[K]V) (MapEntry[K, V], error) {
+	if len(m) == 0 {
+		return MapEntry[K, V]{}, core.ErrEmptyItems
+	}
+	idx, err := rng.Intn(len(m))
+	if err != nil {
+		return MapEntry[K, V]{}, err
+	}
+	for k, v := range m {
+		if idx == 0 {
+			return MapEntry[K, V]{Key: k, Value: v}, nil
+		}
+		idx--
+	}
+	// The map shrank during iteration.
+	return MapEntry[K, V]{}, core.ErrEmptyItems
+}
+
+// sampleMapEntriesWithRNG uses selection sampling (Knuth's Algorithm S):
+// each entry is kept with probability (needed / remaining), then the
+// result is shuffled so its order does not leak map iteration order.
+func sampleMapEntriesWithRNG[K comparable, V any](
+	rng rng, m map[K]V, k int,
+) ([]MapEntry[K, V], error) {
+	if k < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	if k == 0 {
+		return []MapEntry[K, V]{}, nil
+	}
+	n := len(m)
+	if k > n {
+		return nil, core.ErrSampleTooLarge
+	}
+	out := make([]MapEntry[K, V], 0, k)
+	remaining := n
+	for key, v := range m {
+		if len(out) == k {
+			break
+		}
+		needed := k - len(out)
+		if needed < remaining {
+			r, err := rng.Intn(remaining)
+			if err != nil {
+				return nil, err
+			}
+			if r >= needed {
+				remaining--
+				continue
+			}
+		}
+		out = append(out, MapEntry[K, V]{Key: key, Value: v})
+		remaining--
+	}
+	if len(out) != k {
+		return nil, core.ErrSampleTooLarge
+	}
+	if err := shuffleWithRNG(rng, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
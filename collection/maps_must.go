@@ -0,0 +1,34 @@
+//go:build randutil_must
+// +build randutil_must
+
+package collection
+
+// MustPickMapKey returns a uniformly random key of m.
+// It panics if an error occurs.
+func MustPickMapKey[K comparable, V any](m map[K]V) K {
+	key, err := PickMapKey(m)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// MustPickMapValue returns the value of a uniformly random key of m.
+// It panics if an error occurs.
+func MustPickMapValue[K comparable, V any](m map[K]V) V {
+	value, err := PickMapValue(m)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// MustSampleMapEntries returns k distinct entries of m uniformly at random.
+// It panics if an error occurs.
+func MustSampleMapEntries[K comparable, V any](m map[K]V, k int) []MapEntry[K, V] {
+	entries, err := SampleMapEntries(m, k)
+	if err != nil {
+		panic(err)
+	}
+	return entries
+}
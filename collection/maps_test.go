@@ -0,0 +1,88 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestPickMapKeyUniform(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	const n = 20000
+	counts := map[string]int{}
+	for i := 0; i < n; i++ {
+		k, err := PickMapKey(m)
+		if err != nil {
+			t.Fatalf("PickMapKey error: %v", err)
+		}
+		counts[k]++
+	}
+	for k := range m {
+		if frac := float64(counts[k]) / n; math.Abs(frac-0.25) > 0.02 {
+			t.Fatalf("key %s frac=%.3f want 0.25", k, frac)
+		}
+	}
+	v, err := PickMapValue(m)
+	if err != nil || v < 1 || v > 4 {
+		t.Fatalf("PickMapValue=%d err=%v", v, err)
+	}
+}
+
+func TestSampleMapEntries(t *testing.T) {
+	m := map[int]string{}
+	for i := 0; i < 20; i++ {
+		m[i] = string(rune('a' + i))
+	}
+	const trials = 5000
+	counts := make([]int, 20)
+	for trial := 0; trial < trials; trial++ {
+		got, err := SampleMapEntries(m, 5)
+		if err != nil {
+			t.Fatalf("SampleMapEntries error: %v", err)
+		}
+		if len(got) != 5 {
+			t.Fatalf("len=%d want 5", len(got))
+		}
+		seen := map[int]bool{}
+		for _, e := range got {
+			if seen[e.Key] || m[e.Key] != e.Value {
+				t.Fatalf("bad entry %+v in %v", e, got)
+			}
+			seen[e.Key] = true
+			counts[e.Key]++
+		}
+	}
+	want := float64(trials*5) / 20
+	for k, c := range counts {
+		if math.Abs(float64(c)-want) > 0.15*want {
+			t.Fatalf("key %d chosen %d times, want about %.0f", k, c, want)
+		}
+	}
+	all, err := SampleMapEntries(m, 20)
+	if err != nil || len(all) != 20 {
+		t.Fatalf("SampleMapEntries(all) len=%d err=%v", len(all), err)
+	}
+}
+
+func TestMapPickErrors(t *testing.T) {
+	if _, err := PickMapKey(map[int]int{}); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("err=%v want ErrEmptyItems", err)
+	}
+	m := map[int]int{1: 1}
+	if _, err := SampleMapEntries(m, 2); !errors.Is(err, core.ErrSampleTooLarge) {
+		t.Fatalf("err=%v want ErrSampleTooLarge", err)
+	}
+	if _, err := SampleMapEntries(m, -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	if _, err := PickMapValueOf(g, m); !errors.Is(err, errTestOf) {
+		t.Fatalf("err=%v want %v", err, errTestOf)
+	}
+	if _, err := SampleMapEntriesOf(g, map[int]int{1: 1, 2: 2}, 1); !errors.Is(err, errTestOf) {
+		t.Fatalf("err=%v want %v", err, errTestOf)
+	}
+}
@@ -0,0 +1,31 @@
+package collection
+
+import (
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/dist"
+)
+
+// Multinomial returns how many of n independent trials land in each
+// category, where a trial picks category i with probability proportional
+// to weights[i]. It is equivalent to counting n WeightedChoice draws but
+// costs O(len(weights)) binomial draws instead of O(n) choices.
+//
+// Parameters:
+//   - weights: Non-negative, finite weights with at least one > 0.
+//   - n: Number of trials.
+//
+// Returns:
+//   - []int: Per-category counts summing to n.
+//   - error: ErrNegativeLength, ErrEmptyItems, ErrInvalidWeights, or an
+//     RNG error.
+func Multinomial(weights []float64, n int) ([]int, error) {
+	return Default[int]().Multinomial(weights, n)
+}
+
+// Multinomial returns per-category counts for n weighted trials.
+func (g *Generator[T]) Multinomial(weights []float64, n int) ([]int, error) {
+	if len(weights) == 0 && n >= 0 {
+		return nil, core.ErrEmptyItems
+	}
+	return dist.New(g.rngOrDefault()).Multinomial(n, weights)
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestMultinomialSumsAndZeroWeights(t *testing.T) {
+	weights := []float64{0, 2, 0, 5, 3, 0}
+	for _, n := range []int{0, 1, 17, 1000, 5000000} {
+		counts, err := Multinomial(weights, n)
+		if err != nil {
+			t.Fatalf("Multinomial error: %v", err)
+		}
+		total := 0
+		for i, c := range counts {
+			if c < 0 {
+				t.Fatalf("negative count %d", c)
+			}
+			if weights[i] == 0 && c != 0 {
+				t.Fatalf("zero-weight category %d got %d", i, c)
+			}
+			total += c
+		}
+		if total != n {
+			t.Fatalf("total=%d want %d", total, n)
+		}
+	}
+}
+
+func TestMultinomialMeans(t *testing.T) {
+	weights := []float64{1, 2, 7}
+	const (
+		n      = 1000
+		trials = 2000
+	)
+	sums := make([]float64, len(weights))
+	for i := 0; i < trials; i++ {
+		counts, err := Multinomial(weights, n)
+		if err != nil {
+			t.Fatalf("Multinomial error: %v", err)
+		}
+		for j, c := range counts {
+			sums[j] += float64(c)
+		}
+	}
+	for j, w := range weights {
+		mean := sums[j] / trials
+		want := float64(n) * w / 10
+		if math.Abs(mean-want) > 0.02*want+1 {
+			t.Fatalf("category %d mean=%.2f want %.2f", j, mean, want)
+		}
+	}
+}
+
+func TestMultinomialErrors(t *testing.T) {
+	cases := []struct {
+		weights []float64
+		n       int
+		want    error
+	}{
+		{[]float64{1}, -1, core.ErrNegativeLength},
+		{nil, 1, core.ErrEmptyItems},
+		{[]float64{0, 0}, 1, core.ErrInvalidWeights},
+		{[]float64{-1, 2}, 1, core.ErrInvalidWeights},
+		{[]float64{math.NaN()}, 1, core.ErrInvalidWeights},
+	}
+	for _, tc := range cases {
+		if _, err := Multinomial(tc.weights, tc.n); !errors.Is(err, tc.want) {
+			t.Fatalf("Multinomial(%v,%d) err=%v want %v", tc.weights, tc.n, err, tc.want)
+		}
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	if _, err := g.Multinomial([]float64{1, 1}, 10); !errors.Is(err, errTestOf) {
+		t.Fatalf("err=%v want %v", err, errTestOf)
+	}
+}
@@ -0,0 +1,63 @@
+package collection
+
+// Rebind returns a Generator for element type U that shares g's RNG.
+// A nil g yields a generator backed by the package default RNG.
+func Rebind[U, T any](g *Generator[T]) *Generator[U] {
+	return &Generator[U]{rng: g.rngOrDefault()}
+}
+
+// ShuffleOf shuffles slice in place using g's RNG, regardless of the
+// element type g was constructed for.
+//
+// Parameters:
+//   - g: Generator whose RNG is used; nil selects the default RNG.
+//   - slice: Slice to shuffle in place.
+//
+// Returns:
+//   - error: Error from the RNG, if any.
+func ShuffleOf[T, E any](g *Generator[E], slice []T) error {
+	return shuffleWithRNG(g.rngOrDefault(), slice)
+}
+
+// SampleOf returns k items uniformly at random from s without replacement
+// using g's RNG, regardless of the element type g was constructed for.
+//
+// Parameters:
+//   - g: Generator whose RNG is used; nil selects the default RNG.
+//   - s: Source slice; it is not modified.
+//   - k: Number of items to draw.
+//
+// Returns:
+//   - []T: The sampled items.
+//   - error: ErrNegativeLength, ErrSampleTooLarge, or an RNG error.
+func SampleOf[T, E any](g *Generator[E], s []T, k int) ([]T, error) {
+	return sampleWithRNG(g.rngOrDefault(), s, k)
+}
+
+// PickOneOf returns one random element of slice using g's RNG, regardless
+// of the element type g was constructed for.
+//
+// Parameters:
+//   - g: Generator whose RNG is used; nil selects the default RNG.
+//   - slice: Slice to pick from.
+//
+// Returns:
+//   - T: The chosen element.
+//   - error: ErrEmptySlice or an RNG error.
+func PickOneOf[T, E any](g *Generator[E], slice []T) (T, error) {
+	return pickOneWithRNG(g.rngOrDefault(), slice)
+}
+
+// PermOf returns a shuffled copy of slice using g's RNG, regardless of the
+// element type g was constructed for.
+//
+// Parameters:
+//   - g: Generator whose RNG is used; nil selects the default RNG.
+//   - slice: Slice to copy and shuffle; it is not modified.
+//
+// Returns:
+//   - []T: The shuffled copy.
+//   - error: Error from the RNG, if any.
+func PermOf[T, E any](g *Generator[E], slice []T) ([]T, error) {
+	return Rebind[T](g).Perm(slice)
+}
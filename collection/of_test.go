@@ -0,0 +1,53 @@
+package collection
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestOfFunctionsUseGeneratorRNG(t *testing.T) {
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	words := []string{"a", "b", "c"}
+	if err := ShuffleOf(g, words); !errors.Is(err, errTestOf) {
+		t.Fatalf("ShuffleOf err=%v want %v", err, errTestOf)
+	}
+	if _, err := SampleOf(g, words, 2); !errors.Is(err, errTestOf) {
+		t.Fatalf("SampleOf err=%v want %v", err, errTestOf)
+	}
+	if _, err := PickOneOf(g, words); !errors.Is(err, errTestOf) {
+		t.Fatalf("PickOneOf err=%v want %v", err, errTestOf)
+	}
+	if _, err := PermOf(g, words); !errors.Is(err, errTestOf) {
+		t.Fatalf("PermOf err=%v want %v", err, errTestOf)
+	}
+	if err := Rebind[string](g).Shuffle(words); !errors.Is(err, errTestOf) {
+		t.Fatalf("Rebind Shuffle err=%v want %v", err, errTestOf)
+	}
+}
+
+func TestOfFunctionsNilGenerator(t *testing.T) {
+	var g *Generator[int]
+	words := []string{"a", "b", "c", "d"}
+	perm, err := PermOf(g, words)
+	if err != nil {
+		t.Fatalf("PermOf error: %v", err)
+	}
+	sorted := slices.Clone(perm)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, words) {
+		t.Fatalf("PermOf=%v is not a permutation of %v", perm, words)
+	}
+	got, err := SampleOf(g, words, 2)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("SampleOf=%v err=%v", got, err)
+	}
+	if _, err := PickOneOf(g, []string{}); !errors.Is(err, core.ErrEmptySlice) {
+		t.Fatalf("PickOneOf empty err=%v", err)
+	}
+}
+
+var errTestOf = errors.New("of test")
@@ -0,0 +1,65 @@
+package collection
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Pair randomly pairs up all items, e.g. for tournament rounds or review
+// assignments.
+//
+// Parameters:
+//   - items: Items to pair; the slice is not modified.
+//
+// Returns:
+//   - [][2]T: len(items)/2 disjoint pairs; empty when items is empty.
+//   - error: ErrOddItems if len(items) is odd, or an RNG error.
+func Pair[T any](items []T) ([][2]T, error) {
+	return Default[T]().Pair(items)
+}
+
+// PairGroups randomly pairs up items like Pair, but accepts an odd count by
+// making the last group a triple.
+//
+// Parameters:
+//   - items: Items to group; the slice is not modified.
+//
+// Returns:
+//   - [][]T: Groups of two, with one group of three if len(items) is odd.
+//   - error: ErrUnsatisfiable if len(items) == 1, or an RNG error.
+func PairGroups[T any](items []T) ([][]T, error) {
+	return Default[T]().PairGroups(items)
+}
+
+// Pair randomly pairs up all items.
+func (g *Generator[T]) Pair(items []T) ([][2]T, error) {
+	if len(items)%2 != 0 {
+		return nil, core.ErrOddItems
+	}
+	dup, err := g.Perm(items)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][2]T, len(dup)/2)
+	for i := range out {
+		out[i] = [2]T{dup[2*i], dup[2*i+1]}
+	}
+	return out, nil
+}
+
+// PairGroups randomly pairs up items, folding an odd one out into a triple.
+func (g *Generator[T]) PairGroups(items []T) ([][]T, error) {
+	if len(items) == 1 {
+		return nil, core.ErrUnsatisfiable
+	}
+	dup, err := g.Perm(items)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]T, 0, len(dup)/2)
+	for i := 0; i+1 < len(dup); i += 2 {
+		out = append(out, dup[i:i+2:i+2])
+	}
+	if len(dup)%2 != 0 {
+		last := len(out) - 1
+		out[last] = dup[len(dup)-3:]
+	}
+	return out, nil
+}
@@ -0,0 +1,23 @@
+//go:build randutil_must
+// +build randutil_must
+
+package collection
+
+// MustPair randomly pairs up all items. It panics if an error occurs.
+func MustPair[T any](items []T) [][2]T {
+	pairs, err := Pair(items)
+	if err != nil {
+		panic(err)
+	}
+	return pairs
+}
+
+// MustPairGroups randomly pairs up items, folding an odd one out into a
+// triple. It panics if an error occurs.
+func MustPairGroups[T any](items []T) [][]T {
+	groups, err := PairGroups(items)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestPair(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5}
+	pairs, err := Pair(items)
+	if err != nil {
+		t.Fatalf("Pair error: %v", err)
+	}
+	if len(pairs) != 3 {
+		t.Fatalf("len(pairs)=%d want 3", len(pairs))
+	}
+	seen := map[int]bool{}
+	for _, p := range pairs {
+		for _, v := range p {
+			if seen[v] {
+				t.Fatalf("duplicate %d in %v", v, pairs)
+			}
+			seen[v] = true
+		}
+	}
+	if len(seen) != len(items) {
+		t.Fatalf("covered %d items, want %d", len(seen), len(items))
+	}
+	if pairs, err := Pair([]int{}); err != nil || len(pairs) != 0 {
+		t.Fatalf("Pair(empty)=%v err=%v", pairs, err)
+	}
+	if _, err := Pair([]int{1, 2, 3}); !errors.Is(err, core.ErrOddItems) {
+		t.Fatalf("err=%v want ErrOddItems", err)
+	}
+}
+
+func TestPairGroups(t *testing.T) {
+	for _, n := range []int{0, 2, 3, 7, 8} {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i
+		}
+		groups, err := PairGroups(items)
+		if err != nil {
+			t.Fatalf("PairGroups(%d) error: %v", n, err)
+		}
+		if len(groups) != n/2 {
+			t.Fatalf("n=%d len(groups)=%d want %d", n, len(groups), n/2)
+		}
+		seen := map[int]bool{}
+		triples := 0
+		for _, grp := range groups {
+			switch len(grp) {
+			case 2:
+			case 3:
+				triples++
+			default:
+				t.Fatalf("n=%d group of size %d", n, len(grp))
+			}
+			for _, v := range grp {
+				if seen[v] {
+					t.Fatalf("duplicate %d in %v", v, groups)
+				}
+				seen[v] = true
+			}
+		}
+		if len(seen) != n || triples != n%2 {
+			t.Fatalf("n=%d covered=%d triples=%d", n, len(seen), triples)
+		}
+	}
+	if _, err := PairGroups([]int{1}); !errors.Is(err, core.ErrUnsatisfiable) {
+		t.Fatalf("err=%v want ErrUnsatisfiable", err)
+	}
+}
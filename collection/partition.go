@@ -0,0 +1,115 @@
+package collection
+
+import (
+	"math"
+	"sort"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Partition splits a shuffled copy of s into k disjoint groups whose sizes
+// differ by at most one. When k > len(s) the trailing groups are empty.
+//
+// Parameters:
+//   - s: Source slice; it is not modified.
+//   - k: Number of groups.
+//
+// Returns:
+//   - [][]T: The k groups.
+//   - error: ErrNonPositiveBound if k <= 0, or an RNG error.
+func Partition[T any](s []T, k int) ([][]T, error) {
+	return Default[T]().Partition(s, k)
+}
+
+// SplitFractions splits a shuffled copy of s into len(fractions) disjoint
+// groups sized proportionally to fractions, e.g. {0.8, 0.1, 0.1} for a
+// train/validation/test split. Fractions are normalised by their sum and
+// sizes are rounded with the largest-remainder method, so every item lands
+// in exactly one group.
+//
+// Parameters:
+//   - s: Source slice; it is not modified.
+//   - fractions: Non-negative, finite proportions with at least one > 0.
+//
+// Returns:
+//   - [][]T: One group per fraction.
+//   - error: ErrInvalidWeights for bad fractions, or an RNG error.
+func SplitFractions[T any](s []T, fractions []float64) ([][]T, error) {
+	return Default[T]().SplitFractions(s, fractions)
+}
+
+// Partition splits a shuffled copy of s into k groups of near-equal size.
+func (g *Generator[T]) Partition(s []T, k int) ([][]T, error) {
+	if k <= 0 {
+		return nil, core.ErrNonPositiveBound
+	}
+	sizes := make([]int, k)
+	for i := range sizes {
+		sizes[i] = len(s) / k
+		if i < len(s)%k {
+			sizes[i]++
+		}
+	}
+	return g.splitSizes(s, sizes)
+}
+
+// SplitFractions splits a shuffled copy of s proportionally to fractions.
+func (g *Generator[T]) SplitFractions(s []T, fractions []float64) ([][]T, error) {
+	sizes, err := fractionSizes(len(s), fractions)
+	if err != nil {
+		return nil, err
+	}
+	return g.splitSizes(s, sizes)
+}
+
+func (g *Generator[T]) splitSizes(s []T, sizes []int) ([][]T, error) {
+	dup, err := g.Perm(s)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]T, len(sizes))
+	off := 0
+	for i, n := range sizes {
+		out[i] = dup[off : off+n : off+n]
+		off += n
+	}
+	return out, nil
+}
+
+// fractionSizes apportions n items using the largest-remainder method.
+func fractionSizes(n int, fractions []float64) ([]int, error) {
+	if len(fractions) == 0 {
+		return nil, core.ErrInvalidWeights
+	}
+	var sum float64
+	for _, f := range fractions {
+		if f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, core.ErrInvalidWeights
+		}
+		sum += f
+	}
+	if sum <= 0 || math.IsInf(sum, 0) {
+		return nil, core.ErrInvalidWeights
+	}
+	sizes := make([]int, len(fractions))
+	rems := make([]float64, len(fractions))
+	order := make([]int, len(fractions))
+	total := 0
+	for i, f := range fractions {
+		exact := f / sum * float64(n)
+		sizes[i] = int(exact)
+		rems[i] = exact - float64(sizes[i])
+		order[i] = i
+		total += sizes[i]
+	}
+	sort.SliceStable(order, func(a, b int) bool { return rems[order[a]] > rems[order[b]] })
+	for i := 0; total < n; i++ {
+		idx := order[i%len(order)]
+		if fractions[idx] == 0 {
+			continue
+		}
+		sizes[idx]++
+		total++
+	}
+	return sizes, nil
+}
@@ -0,0 +1,24 @@
+//go:build randutil_must
+// +build randutil_must
+
+package collection
+
+// MustPartition splits a shuffled copy of s into k groups of near-equal
+// size. It panics if an error occurs.
+func MustPartition[T any](s []T, k int) [][]T {
+	groups, err := Partition(s, k)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
+
+// MustSplitFractions splits a shuffled copy of s proportionally to
+// fractions. It panics if an error occurs.
+func MustSplitFractions[T any](s []T, fractions []float64) [][]T {
+	groups, err := SplitFractions(s, fractions)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
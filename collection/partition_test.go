@@ -0,0 +1,95 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestPartitionBalanced(t *testing.T) {
+	s := make([]int, 10)
+	for i := range s {
+		s[i] = i
+	}
+	groups, err := Partition(s, 3)
+	if err != nil {
+		t.Fatalf("Partition error: %v", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("len(groups)=%d want 3", len(groups))
+	}
+	seen := map[int]bool{}
+	for i, grp := range groups {
+		want := 3
+		if i == 0 {
+			want = 4
+		}
+		if len(grp) != want {
+			t.Fatalf("group %d len=%d want %d", i, len(grp), want)
+		}
+		for _, v := range grp {
+			if seen[v] {
+				t.Fatalf("duplicate %d", v)
+			}
+			seen[v] = true
+		}
+	}
+	if len(seen) != 10 {
+		t.Fatalf("covered %d items, want 10", len(seen))
+	}
+	for i, v := range s {
+		if v != i {
+			t.Fatal("Partition modified its input")
+		}
+	}
+}
+
+func TestPartitionMoreGroupsThanItems(t *testing.T) {
+	groups, err := Partition([]string{"a", "b"}, 4)
+	if err != nil {
+		t.Fatalf("Partition error: %v", err)
+	}
+	if len(groups) != 4 || len(groups[0]) != 1 || len(groups[1]) != 1 ||
+		len(groups[2]) != 0 || len(groups[3]) != 0 {
+		t.Fatalf("groups=%v", groups)
+	}
+	if _, err := Partition([]int{1}, 0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+}
+
+func TestSplitFractions(t *testing.T) {
+	s := make([]int, 101)
+	for i := range s {
+		s[i] = i
+	}
+	groups, err := SplitFractions(s, []float64{0.8, 0.1, 0.1})
+	if err != nil {
+		t.Fatalf("SplitFractions error: %v", err)
+	}
+	total := 0
+	for _, grp := range groups {
+		total += len(grp)
+	}
+	if total != 101 {
+		t.Fatalf("total=%d want 101", total)
+	}
+	if len(groups[0]) != 81 || len(groups[1]) != 10 || len(groups[2]) != 10 {
+		t.Fatalf("sizes=%d,%d,%d want 81,10,10",
+			len(groups[0]), len(groups[1]), len(groups[2]))
+	}
+	groups, err = SplitFractions(s[:3], []float64{0, 1})
+	if err != nil || len(groups[0]) != 0 || len(groups[1]) != 3 {
+		t.Fatalf("zero fraction groups=%v err=%v", groups, err)
+	}
+}
+
+func TestSplitFractionsErrors(t *testing.T) {
+	for _, fr := range [][]float64{nil, {0, 0}, {-1, 2}, {math.NaN()}, {math.Inf(1)}} {
+		if _, err := SplitFractions([]int{1, 2}, fr); !errors.Is(err, core.ErrInvalidWeights) {
+			t.Fatalf("fractions=%v err=%v want ErrInvalidWeights", fr, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package collection
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Derangement returns a uniformly random permutation of [0,n) in which no
+// element stays in place, e.g. for secret-santa style assignments where
+// p[i] is the partner of i.
+//
+// Parameters:
+//   - n: Number of elements.
+//
+// Returns:
+//   - []int: The derangement; empty when n == 0.
+//   - error: ErrNegativeLength if n < 0, ErrUnsatisfiable if n == 1, or an
+//     RNG error.
+func Derangement(n int) ([]int, error) {
+	return Default[int]().Derangement(n)
+}
+
+// PermK returns a uniformly random ordered selection of k distinct values
+// from [0,n). Memory use is O(k) when k is small relative to n.
+//
+// Parameters:
+//   - n: Size of the range.
+//   - k: Number of values to draw.
+//
+// Returns:
+//   - []int: The k values in draw order.
+//   - error: ErrNegativeLength, ErrSampleTooLarge, or an RNG error.
+func PermK(n, k int) ([]int, error) {
+	return Default[int]().PermK(n, k)
+}
+
+// Derangement returns a random permutation of [0,n) without fixed points.
+func (g *Generator[T]) Derangement(n int) ([]int, error) {
+	if n < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	if n == 1 {
+		return nil, core.ErrUnsatisfiable
+	}
+	p := make([]int, n)
+	rng := g.rngOrDefault()
+	// Rejection keeps the result uniform; about e shuffles are needed on
+	// average.
+	for {
+		for i := range p {
+			p[i] = i
+		}
+		if err := shuffleWithRNG(rng, p); err != nil {
+			return nil, err
+		}
+		if !hasFixedPoint(p) {
+			return p, nil
+		}
+	}
+}
+
+// PermK returns k distinct values from [0,n) in random order.
+func (g *Generator[T]) PermK(n, k int) ([]int, error) {
+	if n < 0 || k < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	if k > n {
+		return nil, core.ErrSampleTooLarge
+	}
+	rng := g.rngOrDefault()
+	out := make([]int, k)
+	if k > n/4 {
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		for i := 0; i < k; i++ {
+			j, err := rng.Intn(n - i)
+			if err != nil {
+				return nil, err
+			}
+			j += i
+			idx[i], idx[j] = idx[j], idx[i]
+		}
+		copy(out, idx[:k])
+		return out, nil
+	}
+	// Sparse Fisher-Yates: only swapped positions are stored.
+	swapped := make(map[int]int, 2*k)
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+	for i := 0; i < k; i++ {
+		j, err := rng.Intn(n - i)
+		if err != nil {
+			return nil, err
+		}
+		j += i
+		vi, vj := at(i), at(j)
+		swapped[j] = vi
+		out[i] = vj
+	}
+	return out, nil
+}
+
+func hasFixedPoint(p []int) bool {
+	for i, v := range p {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
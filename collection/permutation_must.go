@@ -0,0 +1,24 @@
+//go:build randutil_must
+// +build randutil_must
+
+package collection
+
+// MustDerangement returns a random permutation of [0,n) without fixed
+// points. It panics if an error occurs.
+func MustDerangement(n int) []int {
+	p, err := Derangement(n)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MustPermK returns k distinct values from [0,n) in random order.
+// It panics if an error occurs.
+func MustPermK(n, k int) []int {
+	p, err := PermK(n, k)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
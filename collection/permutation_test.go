@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestDerangement(t *testing.T) {
+	for _, n := range []int{0, 2, 3, 10, 100} {
+		p, err := Derangement(n)
+		if err != nil {
+			t.Fatalf("Derangement(%d) error: %v", n, err)
+		}
+		if len(p) != n {
+			t.Fatalf("len=%d want %d", len(p), n)
+		}
+		seen := make([]bool, n)
+		for i, v := range p {
+			if v == i {
+				t.Fatalf("fixed point at %d in %v", i, p)
+			}
+			if seen[v] {
+				t.Fatalf("duplicate %d in %v", v, p)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestDerangementUniform(t *testing.T) {
+	// There are exactly two derangements of three elements.
+	const trials = 10000
+	counts := map[[3]int]int{}
+	for i := 0; i < trials; i++ {
+		p, err := Derangement(3)
+		if err != nil {
+			t.Fatalf("Derangement error: %v", err)
+		}
+		counts[[3]int{p[0], p[1], p[2]}]++
+	}
+	if len(counts) != 2 {
+		t.Fatalf("got %d distinct derangements, want 2", len(counts))
+	}
+	for p, c := range counts {
+		if frac := float64(c) / trials; math.Abs(frac-0.5) > 0.03 {
+			t.Fatalf("%v frac=%.3f want 0.5", p, frac)
+		}
+	}
+}
+
+func TestDerangementErrors(t *testing.T) {
+	if _, err := Derangement(1); !errors.Is(err, core.ErrUnsatisfiable) {
+		t.Fatalf("err=%v want ErrUnsatisfiable", err)
+	}
+	if _, err := Derangement(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+}
+
+func TestPermK(t *testing.T) {
+	for _, tc := range []struct{ n, k int }{{10, 10}, {10, 3}, {1000000, 5}, {5, 0}} {
+		p, err := PermK(tc.n, tc.k)
+		if err != nil {
+			t.Fatalf("PermK(%d,%d) error: %v", tc.n, tc.k, err)
+		}
+		if len(p) != tc.k {
+			t.Fatalf("len=%d want %d", len(p), tc.k)
+		}
+		seen := map[int]bool{}
+		for _, v := range p {
+			if v < 0 || v >= tc.n || seen[v] {
+				t.Fatalf("bad value %d in %v", v, p)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestPermKSparseUniform(t *testing.T) {
+	const (
+		n      = 40
+		trials = 20000
+	)
+	counts := make([]int, n)
+	for i := 0; i < trials; i++ {
+		p, err := PermK(n, 2)
+		if err != nil {
+			t.Fatalf("PermK error: %v", err)
+		}
+		counts[p[1]]++
+	}
+	want := float64(trials) / n
+	for v, c := range counts {
+		if math.Abs(float64(c)-want) > 0.25*want {
+			t.Fatalf("value %d at position 1 %d times, want about %.0f", v, c, want)
+		}
+	}
+}
+
+func TestPermKErrors(t *testing.T) {
+	if _, err := PermK(3, 4); !errors.Is(err, core.ErrSampleTooLarge) {
+		t.Fatalf("err=%v want ErrSampleTooLarge", err)
+	}
+	if _, err := PermK(-1, 0); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+}
@@ -1,7 +1,17 @@
 package collection
 
 // PickByProbability returns items independently with probability p in [0,1].
-// It preserves input order and allocates once.
+// It preserves input order and allocates once. It replaces v1's
+// SlicePickMany: pass percent/100 instead of an integer percentage.
+//
+// Parameters:
+//   - xs: Source slice; it is not modified.
+//   - p: Inclusion probability for each item.
+//
+// Returns:
+//   - []T: The selected items, in input order.
+//   - error: ErrInvalidProbability if p is outside [0,1] or not finite, or
+//     an RNG error.
 func PickByProbability[T any](xs []T, p float64) ([]T, error) {
 	return Default[T]().PickByProbability(xs, p)
 }
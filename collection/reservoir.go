@@ -0,0 +1,223 @@
+package collection
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Reservoir keeps a uniform random sample of at most k items from a stream
+// of unknown length using Li's Algorithm L, which draws O(k log(n/k))
+// random numbers for n items instead of one per item.
+//
+// Concurrency: not safe for concurrent use.
+type Reservoir[T any] struct {
+	rng   rng
+	k     int
+	items []T
+	seen  int
+	w     float64
+	next  int
+}
+
+// NewReservoir returns a Reservoir that keeps k items using the default
+// RNG.
+//
+// Parameters:
+//   - k: Maximum number of items to keep.
+//
+// Returns:
+//   - *Reservoir[T]: The empty reservoir.
+//   - error: ErrNegativeLength if k < 0.
+func NewReservoir[T any](k int) (*Reservoir[T], error) {
+	return Default[T]().NewReservoir(k)
+}
+
+// NewReservoir returns a Reservoir that keeps k items using g's RNG.
+func (g *Generator[T]) NewReservoir(k int) (*Reservoir[T], error) {
+	if k < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	return &Reservoir[T]{rng: g.rngOrDefault(), k: k, items: make([]T, 0, k)}, nil
+}
+
+// Add offers item to the reservoir. Items are copied by value.
+func (r *Reservoir[T]) Add(item T) error {
+	defer func() { r.seen++ }()
+	if r.k == 0 {
+		return nil
+	}
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		if len(r.items) == r.k {
+			return r.advance(true)
+		}
+		return nil
+	}
+	if r.seen < r.next {
+		return nil
+	}
+	j, err := r.rng.Intn(r.k)
+	if err != nil {
+		return err
+	}
+	r.items[j] = item
+	return r.advance(false)
+}
+
+// Result returns a copy of the current sample. Before k items have been
+// added it holds every item seen so far, in arrival order.
+func (r *Reservoir[T]) Result() []T {
+	out := make([]T, len(r.items))
+	copy(out, r.items)
+	return out
+}
+
+// Seen reports how many items have been offered.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// advance updates the acceptance threshold W and computes the index of the
+// next item that will enter the reservoir.
+func (r *Reservoir[T]) advance(first bool) error {
+	u, err := positiveFloat64(r.rng)
+	if err != nil {
+		return err
+	}
+	step := math.Exp(math.Log(u) / float64(r.k))
+	if first {
+		r.w = step
+	} else {
+		r.w *= step
+	}
+	u, err = positiveFloat64(r.rng)
+	if err != nil {
+		return err
+	}
+	skip := math.Floor(math.Log(u) / math.Log1p(-r.w))
+	if math.IsNaN(skip) || skip >= float64(math.MaxInt-r.seen-1) {
+		r.next = math.MaxInt
+		return nil
+	}
+	r.next = r.seen + int(skip) + 1
+	return nil
+}
+
+// WeightedReservoir keeps a weighted random sample of at most k items from
+// a stream of unknown length without replacement, using the
+// Efraimidis–Spirakis A-Res method. Zero-weight items are never kept.
+//
+// Concurrency: not safe for concurrent use.
+type WeightedReservoir[T any] struct {
+	rng  rng
+	k    int
+	keys weightedKeys[T]
+	seen int
+}
+
+// NewWeightedReservoir returns a WeightedReservoir that keeps k items using
+// the default RNG.
+//
+// Parameters:
+//   - k: Maximum number of items to keep.
+//
+// Returns:
+//   - *WeightedReservoir[T]: The empty reservoir.
+//   - error: ErrNegativeLength if k < 0.
+func NewWeightedReservoir[T any](k int) (*WeightedReservoir[T], error) {
+	return Default[T]().NewWeightedReservoir(k)
+}
+
+// NewWeightedReservoir returns a WeightedReservoir that keeps k items using
+// g's RNG.
+func (g *Generator[T]) NewWeightedReservoir(k int) (*WeightedReservoir[T], error) {
+	if k < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	return &WeightedReservoir[T]{
+		rng:  g.rngOrDefault(),
+		k:    k,
+		keys: make(weightedKeys[T], 0, k),
+	}, nil
+}
+
+// Add offers item with the given non-negative weight. Returns
+// ErrInvalidWeights for negative, NaN or infinite weights.
+func (r *WeightedReservoir[T]) Add(item T, weight float64) error {
+	if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+		return core.ErrInvalidWeights
+	}
+	r.seen++
+	if weight == 0 || r.k == 0 {
+		return nil
+	}
+	u, err := positiveFloat64(r.rng)
+	if err != nil {
+		return err
+	}
+	key := -math.Log(u) / weight
+	if len(r.keys) < r.k {
+		heap.Push(&r.keys, weightedKey[T]{key: key, item: item})
+		return nil
+	}
+	if key < r.keys[0].key {
+		r.keys[0] = weightedKey[T]{key: key, item: item}
+		heap.Fix(&r.keys, 0)
+	}
+	return nil
+}
+
+// Result returns a copy of the current sample ordered from the most to the
+// least favoured draw.
+func (r *WeightedReservoir[T]) Result() []T {
+	sorted := make(weightedKeys[T], len(r.keys))
+	copy(sorted, r.keys)
+	out := make([]T, len(sorted))
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(&sorted).(weightedKey[T]).item
+	}
+	return out
+}
+
+// Seen reports how many items have been offered, including zero-weight
+// items.
+func (r *WeightedReservoir[T]) Seen() int {
+	return r.seen
+}
+
+type weightedKey[T any] struct {
+	key  float64
+	item T
+}
+
+// weightedKeys is a max-heap on key, so the root is the item to evict.
+type weightedKeys[T any] []weightedKey[T]
+
+func (h weightedKeys[T]) Len() int           { return len(h) }
+func (h weightedKeys[T]) Less(i, j int) bool { return h[i].key > h[j].key }
+func (h weightedKeys[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *weightedKeys[T]) Push(x any) { *h = append(*h, x.(weightedKey[T])) }
+
+func (h *weightedKeys[T]) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// positiveFloat64 draws from (0,1) so that logarithms stay finite.
+func positiveFloat64(rng rng) (float64, error) {
+	for {
+		u, err := rng.Float64()
+		if err != nil {
+			return 0, err
+		}
+		if u > 0 {
+			return u, nil
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestReservoirFillsBeforeSampling(t *testing.T) {
+	r, err := NewReservoir[int](5)
+	if err != nil {
+		t.Fatalf("NewReservoir error: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := r.Add(i); err != nil {
+			t.Fatalf("Add error: %v", err)
+		}
+	}
+	got := r.Result()
+	if len(got) != 3 || got[0] != 0 || got[1] != 1 || got[2] != 2 {
+		t.Fatalf("Result=%v want [0 1 2]", got)
+	}
+	if r.Seen() != 3 {
+		t.Fatalf("Seen=%d want 3", r.Seen())
+	}
+}
+
+func TestReservoirUniform(t *testing.T) {
+	const (
+		n      = 50
+		k      = 5
+		trials = 20000
+	)
+	counts := make([]int, n)
+	for trial := 0; trial < trials; trial++ {
+		r, err := NewReservoir[int](k)
+		if err != nil {
+			t.Fatalf("NewReservoir error: %v", err)
+		}
+		for i := 0; i < n; i++ {
+			if err := r.Add(i); err != nil {
+				t.Fatalf("Add error: %v", err)
+			}
+		}
+		got := r.Result()
+		if len(got) != k {
+			t.Fatalf("len(Result)=%d want %d", len(got), k)
+		}
+		seen := map[int]bool{}
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("duplicate %d in %v", v, got)
+			}
+			seen[v] = true
+			counts[v]++
+		}
+	}
+	want := float64(trials*k) / n
+	for i, c := range counts {
+		if math.Abs(float64(c)-want) > 0.2*want {
+			t.Fatalf("item %d chosen %d times, want about %.0f", i, c, want)
+		}
+	}
+}
+
+func TestReservoirEdgeCases(t *testing.T) {
+	if _, err := NewReservoir[int](-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	r, err := NewReservoir[int](0)
+	if err != nil {
+		t.Fatalf("NewReservoir error: %v", err)
+	}
+	if err := r.Add(1); err != nil {
+		t.Fatalf("Add error: %v", err)
+	}
+	if got := r.Result(); len(got) != 0 {
+		t.Fatalf("Result=%v want empty", got)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	r, err = g.NewReservoir(1)
+	if err != nil {
+		t.Fatalf("NewReservoir error: %v", err)
+	}
+	if err := r.Add(1); !errors.Is(err, errTestOf) {
+		t.Fatalf("Add err=%v want %v", err, errTestOf)
+	}
+}
+
+func TestWeightedReservoirFavoursHeavyItems(t *testing.T) {
+	const trials = 5000
+	heavy := 0
+	for trial := 0; trial < trials; trial++ {
+		r, err := NewWeightedReservoir[string](1)
+		if err != nil {
+			t.Fatalf("NewWeightedReservoir error: %v", err)
+		}
+		for _, it := range []struct {
+			name string
+			w    float64
+		}{{"light", 1}, {"never", 0}, {"heavy", 9}} {
+			if err := r.Add(it.name, it.w); err != nil {
+				t.Fatalf("Add error: %v", err)
+			}
+		}
+		got := r.Result()
+		if len(got) != 1 || got[0] == "never" {
+			t.Fatalf("Result=%v", got)
+		}
+		if got[0] == "heavy" {
+			heavy++
+		}
+	}
+	if frac := float64(heavy) / trials; math.Abs(frac-0.9) > 0.03 {
+		t.Fatalf("heavy fraction=%.3f want about 0.9", frac)
+	}
+}
+
+func TestWeightedReservoirKeepsAtMostK(t *testing.T) {
+	r, err := NewWeightedReservoir[int](3)
+	if err != nil {
+		t.Fatalf("NewWeightedReservoir error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if err := r.Add(i, float64(i%4)); err != nil {
+			t.Fatalf("Add error: %v", err)
+		}
+	}
+	got := r.Result()
+	if len(got) != 3 {
+		t.Fatalf("len(Result)=%d want 3", len(got))
+	}
+	for _, v := range got {
+		if v%4 == 0 {
+			t.Fatalf("zero-weight item %d selected", v)
+		}
+	}
+	if r.Seen() != 100 {
+		t.Fatalf("Seen=%d want 100", r.Seen())
+	}
+	if err := r.Add(1, math.NaN()); !errors.Is(err, core.ErrInvalidWeights) {
+		t.Fatalf("err=%v want ErrInvalidWeights", err)
+	}
+	if _, err := NewWeightedReservoir[int](-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+}
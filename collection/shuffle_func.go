@@ -0,0 +1,33 @@
+package collection
+
+import "github.com/aatuh/randutil/v2/core"
+
+// ShuffleFunc performs a secure Fisher-Yates shuffle of n elements through
+// swap, in the style of sort.Interface, so structures that are not a single
+// slice (paired slices, columnar data) can be shuffled in place.
+//
+// Parameters:
+//   - n: Number of elements.
+//   - swap: Swaps the elements with indexes i and j.
+//
+// Returns:
+//   - error: ErrNegativeLength if n < 0, or an RNG error.
+func ShuffleFunc(n int, swap func(i, j int)) error {
+	return Default[int]().ShuffleFunc(n, swap)
+}
+
+// ShuffleFunc shuffles n elements in place through swap.
+func (g *Generator[T]) ShuffleFunc(n int, swap func(i, j int)) error {
+	if n < 0 {
+		return core.ErrNegativeLength
+	}
+	rng := g.rngOrDefault()
+	for i := n - 1; i > 0; i-- {
+		j, err := rng.Intn(i + 1)
+		if err != nil {
+			return err
+		}
+		swap(i, j)
+	}
+	return nil
+}
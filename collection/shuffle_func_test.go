@@ -0,0 +1,44 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestShuffleFuncPairedSlices(t *testing.T) {
+	keys := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	vals := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	err := ShuffleFunc(len(keys), func(i, j int) {
+		keys[i], keys[j] = keys[j], keys[i]
+		vals[i], vals[j] = vals[j], vals[i]
+	})
+	if err != nil {
+		t.Fatalf("ShuffleFunc error: %v", err)
+	}
+	seen := map[int]bool{}
+	for i, k := range keys {
+		if vals[i] != string(rune('a'+k)) {
+			t.Fatalf("pair broken at %d: %d/%s", i, k, vals[i])
+		}
+		seen[k] = true
+	}
+	if len(seen) != len(keys) {
+		t.Fatalf("not a permutation: %v", keys)
+	}
+}
+
+func TestShuffleFuncErrors(t *testing.T) {
+	if err := ShuffleFunc(-1, func(i, j int) {}); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	if err := ShuffleFunc(1, func(i, j int) { t.Fatal("swap called for n=1") }); err != nil {
+		t.Fatalf("ShuffleFunc(1) error: %v", err)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	if err := g.ShuffleFunc(3, func(i, j int) {}); !errors.Is(err, errTestOf) {
+		t.Fatalf("err=%v want %v", err, errTestOf)
+	}
+}
@@ -45,3 +45,11 @@ func MustPerm[T any](slice []T) []T {
 	}
 	return p
 }
+
+// MustShuffleFunc shuffles n elements in place through swap.
+// It panics if an error occurs.
+func MustShuffleFunc(n int, swap func(i, j int)) {
+	if err := ShuffleFunc(n, swap); err != nil {
+		panic(err)
+	}
+}
@@ -22,3 +22,23 @@ func MustWeightedSample[T any](items []T, weights []float64, k int) []T {
 	}
 	return result
 }
+
+// MustWeightedShuffle returns a weighted random ordering of items.
+// It panics on error.
+func MustWeightedShuffle[T any](items []T, weights []float64) []T {
+	out, err := WeightedShuffle(items, weights)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// MustMultinomial returns per-category counts for n weighted trials.
+// It panics on error.
+func MustMultinomial(weights []float64, n int) []int {
+	counts, err := Multinomial(weights, n)
+	if err != nil {
+		panic(err)
+	}
+	return counts
+}
@@ -0,0 +1,68 @@
+package collection
+
+import (
+	"math"
+	"sort"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// WeightedShuffle returns a full random ordering of items where items with
+// larger weights tend to appear earlier, using Efraimidis–Spirakis keys.
+// The first element is distributed as WeightedChoice; each following
+// element is a weighted draw from those that remain. Zero-weight items are
+// placed last in uniformly random order.
+//
+// Parameters:
+//   - items: Items to order. The slice is not modified.
+//   - weights: Non-negative weight per item.
+//
+// Returns:
+//   - []T: A new slice holding every item exactly once.
+//   - error: ErrWeightsMismatch if the lengths differ, ErrInvalidWeights for
+//     negative, NaN or infinite weights, or an RNG error.
+func WeightedShuffle[T any](items []T, weights []float64) ([]T, error) {
+	return Default[T]().WeightedShuffle(items, weights)
+}
+
+// WeightedShuffle returns a weighted random ordering of items using g's RNG.
+func (g *Generator[T]) WeightedShuffle(items []T, weights []float64) ([]T, error) {
+	if len(items) != len(weights) {
+		return nil, core.ErrWeightsMismatch
+	}
+	type kv struct {
+		key float64
+		i   int
+	}
+	rng := g.rngOrDefault()
+	keys := make([]kv, 0, len(items))
+	var zeros []int
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, core.ErrInvalidWeights
+		}
+		if w == 0 {
+			zeros = append(zeros, i)
+			continue
+		}
+		u, err := positiveFloat64(rng)
+		if err != nil {
+			return nil, err
+		}
+		// log(-log(u)/w) keeps the ordering of -log(u)/w but stays finite
+		// for tiny weights.
+		keys = append(keys, kv{key: math.Log(-math.Log(u)) - math.Log(w), i: i})
+	}
+	if err := shuffleWithRNG(rng, zeros); err != nil {
+		return nil, err
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].key < keys[j].key })
+	out := make([]T, 0, len(items))
+	for _, k := range keys {
+		out = append(out, items[k.i])
+	}
+	for _, i := range zeros {
+		out = append(out, items[i])
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestWeightedShuffleIsPermutation(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	weights := []float64{5, 0, 1, 0, math.SmallestNonzeroFloat64}
+	out, err := WeightedShuffle(items, weights)
+	if err != nil {
+		t.Fatalf("WeightedShuffle error: %v", err)
+	}
+	sorted := append([]int(nil), out...)
+	sort.Ints(sorted)
+	for i, v := range sorted {
+		if v != i+1 {
+			t.Fatalf("WeightedShuffle is not a permutation: %v", out)
+		}
+	}
+	if items[0] != 1 || items[4] != 5 {
+		t.Fatalf("WeightedShuffle modified its input: %v", items)
+	}
+	for _, v := range out[3:] {
+		if v != 2 && v != 4 {
+			t.Fatalf("zero-weight items not last: %v", out)
+		}
+	}
+}
+
+func TestWeightedShuffleFavoursLargerWeights(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	weights := []float64{1, 2, 7}
+	const n = 20000
+	first := map[string]int{}
+	for i := 0; i < n; i++ {
+		out, err := WeightedShuffle(items, weights)
+		if err != nil {
+			t.Fatalf("WeightedShuffle error: %v", err)
+		}
+		first[out[0]]++
+	}
+	for i, item := range items {
+		got := float64(first[item]) / n
+		want := weights[i] / 10
+		if math.Abs(got-want) > 0.02 {
+			t.Fatalf("P(first=%s)=%.3f want %.3f", item, got, want)
+		}
+	}
+}
+
+func TestWeightedShuffleErrors(t *testing.T) {
+	if _, err := WeightedShuffle([]int{1, 2}, []float64{1}); !errors.Is(err, core.ErrWeightsMismatch) {
+		t.Fatalf("err=%v want ErrWeightsMismatch", err)
+	}
+	for _, w := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := WeightedShuffle([]int{1, 2}, []float64{1, w}); !errors.Is(err, core.ErrInvalidWeights) {
+			t.Fatalf("weight %v: err=%v want ErrInvalidWeights", w, err)
+		}
+	}
+	out, err := WeightedShuffle([]int{}, nil)
+	if err != nil || len(out) != 0 {
+		t.Fatalf("empty WeightedShuffle = %v, %v", out, err)
+	}
+	g := NewWithSource[int](testutil.ErrReader{Err: errTestOf})
+	if _, err := g.WeightedShuffle([]int{1, 2}, []float64{1, 1}); !errors.Is(err, errTestOf) {
+		t.Fatalf("err=%v want %v", err, errTestOf)
+	}
+}
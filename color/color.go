@@ -0,0 +1,83 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// Color is a 24-bit sRGB color.
+type Color struct {
+	R uint8
+	G uint8
+	B uint8
+}
+
+// Hex returns c as a lowercase "#rrggbb" string.
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// String returns c.Hex().
+func (c Color) String() string { return c.Hex() }
+
+// HSL returns c in HSL space with hue in degrees [0, 360) and saturation and
+// lightness in [0, 1].
+func (c Color) HSL() (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	l = (maxC + minC) / 2
+	d := maxC - minC
+	if d == 0 {
+		return 0, 0, l
+	}
+	s = d / (1 - math.Abs(2*l-1))
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, l
+}
+
+// FromHSL converts hue in degrees and saturation and lightness in [0, 1]
+// to a Color. Hue is taken modulo 360; s and l are clamped to [0, 1].
+func FromHSL(h, s, l float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	s = math.Max(0, math.Min(1, s))
+	l = math.Max(0, math.Min(1, l))
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	return Color{R: channel(r + m), G: channel(g + m), B: channel(b + m)}
+}
+
+// channel scales v in [0, 1] to a rounded 8-bit channel.
+func channel(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255)) // #nosec G115 -- clamped to [0,255]
+}
@@ -0,0 +1,40 @@
+//go:build randutil_must
+// +build randutil_must
+
+package color
+
+// MustRGB returns a uniformly random color. It panics on error.
+func MustRGB() Color {
+	c, err := RGB()
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// MustHex returns a uniformly random "#rrggbb" string. It panics on error.
+func MustHex() string {
+	h, err := Hex()
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// MustHSL returns a random color within opts. It panics on error.
+func MustHSL(opts HSLOptions) Color {
+	c, err := HSL(opts)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// MustPalette returns n visually distinct colors. It panics on error.
+func MustPalette(n int, opts HSLOptions) []Color {
+	p, err := Palette(n, opts)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,144 @@
+package color
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestHexAndRGB(t *testing.T) {
+	re := regexp.MustCompile(`^#[0-9a-f]{6}$`)
+	for i := 0; i < 100; i++ {
+		h, err := Hex()
+		if err != nil || !re.MatchString(h) {
+			t.Fatalf("Hex=%q err=%v", h, err)
+		}
+	}
+	g := New(core.New(testutil.NewSeqReader([]byte{0x12, 0xab, 0xff})))
+	c, err := g.RGB()
+	if err != nil || c != (Color{0x12, 0xab, 0xff}) || c.String() != "#12abff" {
+		t.Fatalf("RGB=%v err=%v", c, err)
+	}
+}
+
+func TestHSLRoundTrip(t *testing.T) {
+	cases := map[Color][3]float64{
+		{255, 0, 0}:     {0, 1, 0.5},
+		{0, 255, 0}:     {120, 1, 0.5},
+		{0, 0, 255}:     {240, 1, 0.5},
+		{255, 255, 255}: {0, 0, 1},
+		{0, 0, 0}:       {0, 0, 0},
+		{128, 128, 128}: {0, 0, 128.0 / 255},
+	}
+	for c, want := range cases {
+		if got := FromHSL(want[0], want[1], want[2]); got != c {
+			t.Fatalf("FromHSL(%v)=%v want %v", want, got, c)
+		}
+		h, s, l := c.HSL()
+		if math.Abs(h-want[0]) > 1e-9 || math.Abs(s-want[1]) > 1e-9 || math.Abs(l-want[2]) > 1e-9 {
+			t.Fatalf("%v.HSL()=%v,%v,%v want %v", c, h, s, l, want)
+		}
+	}
+	for i := 0; i < 500; i++ {
+		c, _ := RGB()
+		if got := FromHSL(c.HSL()); got != c {
+			t.Fatalf("round trip %v -> %v", c, got)
+		}
+	}
+}
+
+// checkIn reports whether v is within r, allowing for 8-bit rounding.
+func checkIn(t *testing.T, what string, v float64, r Range) {
+	t.Helper()
+	const eps = 0.02
+	if v < r.Min-eps || v > r.Max+eps {
+		t.Fatalf("%s=%.3f outside [%.3f, %.3f]", what, v, r.Min, r.Max)
+	}
+}
+
+func TestHSLTones(t *testing.T) {
+	for tone := ToneVivid; tone <= ToneDark; tone++ {
+		for i := 0; i < 200; i++ {
+			c, err := HSL(HSLOptions{Tone: tone})
+			if err != nil {
+				t.Fatalf("HSL error: %v", err)
+			}
+			_, s, l := c.HSL()
+			checkIn(t, "lightness", l, toneRanges[tone].l)
+			// Saturation is ill-conditioned near black and white, so only
+			// check it for mid lightness.
+			if l > 0.2 && l < 0.8 {
+				checkIn(t, "saturation", s, toneRanges[tone].s)
+			}
+		}
+	}
+}
+
+func TestHSLHueRange(t *testing.T) {
+	opts := HSLOptions{Tone: ToneVivid, Hue: Range{330, 30}}
+	for i := 0; i < 300; i++ {
+		c, err := HSL(opts)
+		if err != nil {
+			t.Fatalf("HSL error: %v", err)
+		}
+		h, _, _ := c.HSL()
+		if h > 31 && h < 329 {
+			t.Fatalf("hue %.1f outside wrapped range [330, 30]", h)
+		}
+	}
+}
+
+func TestPaletteDistinct(t *testing.T) {
+	p, err := Palette(12, HSLOptions{})
+	if err != nil || len(p) != 12 {
+		t.Fatalf("Palette len=%d err=%v", len(p), err)
+	}
+	hues := make([]float64, len(p))
+	for i, c := range p {
+		hues[i], _, _ = c.HSL()
+	}
+	for i := range hues {
+		for j := i + 1; j < len(hues); j++ {
+			d := math.Abs(hues[i] - hues[j])
+			d = math.Min(d, 360-d)
+			if d < 10 {
+				t.Fatalf("colors %v and %v have hues %.1f apart", p[i], p[j], d)
+			}
+		}
+	}
+	if p, err := Palette(0, HSLOptions{}); err != nil || len(p) != 0 {
+		t.Fatalf("Palette(0)=%v err=%v", p, err)
+	}
+}
+
+func TestColorErrors(t *testing.T) {
+	cases := []struct {
+		opts HSLOptions
+		want error
+	}{
+		{HSLOptions{Tone: Tone(99)}, ErrUnknownTone},
+		{HSLOptions{Hue: Range{-1, 30}}, ErrInvalidComponent},
+		{HSLOptions{Saturation: Range{0, 1.5}}, ErrInvalidComponent},
+		{HSLOptions{Lightness: Range{0.8, 0.2}}, core.ErrMinGreaterThanMax},
+	}
+	for _, tc := range cases {
+		if _, err := HSL(tc.opts); !errors.Is(err, tc.want) {
+			t.Fatalf("HSL(%+v) err=%v want %v", tc.opts, err, tc.want)
+		}
+		if _, err := Palette(3, tc.opts); !errors.Is(err, tc.want) {
+			t.Fatalf("Palette(%+v) err=%v want %v", tc.opts, err, tc.want)
+		}
+	}
+	if _, err := Palette(-1, HSLOptions{}); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.Hex(); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
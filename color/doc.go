@@ -0,0 +1,5 @@
+// Package color generates random colors: uniform RGB and hex strings, HSL
+// colors restricted to pleasant tones such as pastel or dark, and palettes
+// of visually distinct colors for charts and avatars. Generators are
+// concurrency-safe iff the injected RNG is safe.
+package color
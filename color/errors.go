@@ -0,0 +1,9 @@
+package color
+
+import "errors"
+
+// Package-level errors for color generation.
+var (
+	ErrInvalidComponent = errors.New("randutil: color component out of range")
+	ErrUnknownTone      = errors.New("randutil: unknown tone")
+)
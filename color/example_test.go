@@ -0,0 +1,13 @@
+package color
+
+import "fmt"
+
+func ExamplePalette() {
+	colors, err := Palette(5, HSLOptions{Tone: TonePastel})
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	fmt.Println(len(colors))
+	// Output: 5
+}
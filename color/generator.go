@@ -0,0 +1,30 @@
+package color
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Generator builds random colors using a core RNG.
+//
+// Concurrency: safe for concurrent use if the underlying RNG is safe.
+type Generator struct {
+	rng rng
+}
+
+// New returns a color Generator. If rng is nil, crypto/rand is used.
+func New(rng rng) *Generator {
+	if rng == nil {
+		rng = core.New(nil)
+	}
+	return &Generator{rng: rng}
+}
+
+// NewWithSource returns a color Generator bound to src.
+func NewWithSource(src core.Source) *Generator {
+	return New(core.New(src))
+}
+
+var defaultGenerator = New(nil)
+
+// Default returns the package-wide default generator.
+func Default() *Generator {
+	return defaultGenerator
+}
@@ -0,0 +1,152 @@
+package color
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Tone selects preset saturation and lightness ranges for HSL and Palette.
+type Tone int
+
+// Supported tones.
+const (
+	// ToneAny allows any saturation and lightness.
+	ToneAny Tone = iota
+	// ToneVivid yields saturated mid-lightness colors.
+	ToneVivid
+	// TonePastel yields soft, light colors.
+	TonePastel
+	// ToneDark yields deep colors that carry white text well.
+	ToneDark
+)
+
+// toneRanges holds the saturation and lightness ranges of each Tone.
+var toneRanges = [...]struct{ s, l Range }{
+	ToneAny:    {Range{0, 1}, Range{0, 1}},
+	ToneVivid:  {Range{0.75, 1}, Range{0.45, 0.6}},
+	TonePastel: {Range{0.55, 0.85}, Range{0.78, 0.88}},
+	ToneDark:   {Range{0.5, 0.85}, Range{0.15, 0.3}},
+}
+
+func (t Tone) valid() bool { return t >= ToneAny && int(t) < len(toneRanges) }
+
+// Range is an inclusive interval. The zero Range means "use the default".
+type Range struct {
+	Min float64
+	Max float64
+}
+
+func (r Range) isZero() bool { return r == Range{} }
+
+// HSLOptions configures HSL and Palette. Explicit ranges override the
+// corresponding range of Tone.
+type HSLOptions struct {
+	// Tone picks preset saturation and lightness ranges.
+	Tone Tone
+
+	// Hue restricts the hue in degrees within [0, 360]. If Min > Max the
+	// range wraps through 0, so {330, 30} yields reds. The zero value
+	// allows every hue.
+	Hue Range
+
+	// Saturation restricts saturation within [0, 1].
+	Saturation Range
+
+	// Lightness restricts lightness within [0, 1].
+	Lightness Range
+}
+
+// hslSpace is a validated HSLOptions: hue starts at hueMin and spans
+// hueWidth degrees.
+type hslSpace struct {
+	hueMin, hueWidth float64
+	s, l             Range
+}
+
+func (o HSLOptions) space(op string) (hslSpace, error) {
+	if !o.Tone.valid() {
+		return hslSpace{}, &core.ArgError{Op: op, Arg: "Tone", Value: o.Tone, Err: ErrUnknownTone}
+	}
+	sp := hslSpace{hueWidth: 360, s: toneRanges[o.Tone].s, l: toneRanges[o.Tone].l}
+	if !o.Hue.isZero() {
+		if !inUnit(o.Hue.Min/360) || !inUnit(o.Hue.Max/360) {
+			return hslSpace{}, &core.ArgError{Op: op, Arg: "Hue", Value: o.Hue, Err: ErrInvalidComponent}
+		}
+		sp.hueMin, sp.hueWidth = o.Hue.Min, o.Hue.Max-o.Hue.Min
+		if sp.hueWidth < 0 {
+			sp.hueWidth += 360
+		}
+	}
+	for _, c := range []struct {
+		arg string
+		r   Range
+		dst *Range
+	}{
+		{"Saturation", o.Saturation, &sp.s},
+		{"Lightness", o.Lightness, &sp.l},
+	} {
+		if c.r.isZero() {
+			continue
+		}
+		if !inUnit(c.r.Min) || !inUnit(c.r.Max) {
+			return hslSpace{}, &core.ArgError{Op: op, Arg: c.arg, Value: c.r, Err: ErrInvalidComponent}
+		}
+		if c.r.Min > c.r.Max {
+			return hslSpace{}, &core.RangeError{Op: op, Min: c.r.Min, Max: c.r.Max, Err: core.ErrMinGreaterThanMax}
+		}
+		*c.dst = c.r
+	}
+	return sp, nil
+}
+
+func inUnit(v float64) bool { return v >= 0 && v <= 1 }
+
+// HSL returns a random color drawn uniformly in HSL space within opts from
+// the default generator.
+//
+// Parameters:
+//   - opts: Tone and optional hue, saturation and lightness ranges.
+//
+// Returns:
+//   - Color: A random color.
+//   - error: ErrUnknownTone, ErrInvalidComponent or ErrMinGreaterThanMax
+//     for bad options, or an RNG error.
+func HSL(opts HSLOptions) (Color, error) {
+	return Default().HSL(opts)
+}
+
+// HSL returns a random color drawn uniformly in HSL space within opts.
+func (g *Generator) HSL(opts HSLOptions) (Color, error) {
+	sp, err := opts.space("HSL")
+	if err != nil {
+		return Color{}, err
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return Color{}, err
+	}
+	return g.colorAt(sp, u)
+}
+
+// colorAt returns the color at hue fraction u of sp with random saturation
+// and lightness.
+func (g *Generator) colorAt(sp hslSpace, u float64) (Color, error) {
+	s, err := g.within(sp.s)
+	if err != nil {
+		return Color{}, err
+	}
+	l, err := g.within(sp.l)
+	if err != nil {
+		return Color{}, err
+	}
+	return FromHSL(math.Mod(sp.hueMin+u*sp.hueWidth, 360), s, l), nil
+}
+
+func (g *Generator) within(r Range) (float64, error) {
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	return r.Min + u*(r.Max-r.Min), nil
+}
@@ -0,0 +1,53 @@
+package color
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// invPhi is 1/φ; stepping hues by it never repeats and keeps successive
+// colors far apart (the golden-angle method).
+const invPhi = 0.6180339887498949
+
+// Palette returns n visually distinct colors from the default generator.
+//
+// Parameters:
+//   - n: Number of colors.
+//   - opts: As for HSL, except that ToneAny is treated as ToneVivid
+//     because near-black and near-white colors are hard to tell apart.
+//
+// Returns:
+//   - []Color: n colors whose hues are spread by the golden ratio from a
+//     random start, so any prefix of the palette is also well spread.
+//   - error: ErrNegativeLength if n < 0, an options error as for HSL, or
+//     an RNG error.
+func Palette(n int, opts HSLOptions) ([]Color, error) {
+	return Default().Palette(n, opts)
+}
+
+// Palette returns n visually distinct colors.
+func (g *Generator) Palette(n int, opts HSLOptions) ([]Color, error) {
+	if n < 0 {
+		return nil, &core.ArgError{Op: "Palette", Arg: "n", Value: n, Err: core.ErrNegativeLength}
+	}
+	if opts.Tone == ToneAny {
+		opts.Tone = ToneVivid
+	}
+	sp, err := opts.space("Palette")
+	if err != nil {
+		return nil, err
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Color, n)
+	for i := range out {
+		if out[i], err = g.colorAt(sp, u); err != nil {
+			return nil, err
+		}
+		u = math.Mod(u+invPhi, 1)
+	}
+	return out, nil
+}
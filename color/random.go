@@ -0,0 +1,39 @@
+package color
+
+// RGB returns a color uniformly distributed over all 2^24 sRGB values from
+// the default generator.
+//
+// Returns:
+//   - Color: A random color.
+//   - error: An error if the RNG fails.
+func RGB() (Color, error) {
+	return Default().RGB()
+}
+
+// Hex returns a uniformly random "#rrggbb" color string from the default
+// generator.
+//
+// Returns:
+//   - string: A lowercase hex color such as "#3fa2c7".
+//   - error: An error if the RNG fails.
+func Hex() (string, error) {
+	return Default().Hex()
+}
+
+// RGB returns a color uniformly distributed over all 2^24 sRGB values.
+func (g *Generator) RGB() (Color, error) {
+	var b [3]byte
+	if err := g.rng.Fill(b[:]); err != nil {
+		return Color{}, err
+	}
+	return Color{R: b[0], G: b[1], B: b[2]}, nil
+}
+
+// Hex returns a uniformly random "#rrggbb" color string.
+func (g *Generator) Hex() (string, error) {
+	c, err := g.RGB()
+	if err != nil {
+		return "", err
+	}
+	return c.Hex(), nil
+}
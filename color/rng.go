@@ -0,0 +1,6 @@
+package color
+
+type rng interface {
+	Fill(p []byte) error
+	Float64() (float64, error)
+}
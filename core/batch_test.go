@@ -0,0 +1,76 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type countingReader struct {
+	calls int
+	src   io.Reader
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	c.calls++
+	return c.src.Read(p)
+}
+
+func TestUint64sMatchesSequentialReads(t *testing.T) {
+	want := []uint64{1, 2, 1 << 40, ^uint64(0)}
+	var chunks [][]byte
+	for _, v := range want {
+		chunks = append(chunks, testutil.Uint64Bytes(v))
+	}
+	src := &countingReader{src: testutil.NewSeqReader(chunks...)}
+	got, err := New(src).Uint64s(len(want))
+	if err != nil {
+		t.Fatalf("Uint64s error: %v", err)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Uint64s()[%d]=%d want %d", i, got[i], want[i])
+		}
+	}
+	if src.calls != 1 {
+		t.Fatalf("Uint64s issued %d reads want 1", src.calls)
+	}
+}
+
+func TestFloat64sMatchesFloat64(t *testing.T) {
+	payload := append(testutil.Float64Bytes(0.25), testutil.Float64Bytes(0.75)...)
+	got, err := New(testutil.NewSeqReader(payload)).Float64s(2)
+	if err != nil {
+		t.Fatalf("Float64s error: %v", err)
+	}
+	gen := New(testutil.NewSeqReader(payload))
+	for i := range got {
+		want, err := gen.Float64()
+		if err != nil {
+			t.Fatalf("Float64 error: %v", err)
+		}
+		if got[i] != want {
+			t.Fatalf("Float64s()[%d]=%v want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestBatchErrors(t *testing.T) {
+	gen := New(testutil.NewSeqReader())
+	if _, err := gen.Uint64s(-1); !errors.Is(err, ErrNegativeLength) {
+		t.Fatalf("Uint64s(-1) err=%v want ErrNegativeLength", err)
+	}
+	if _, err := gen.Float64s(-1); !errors.Is(err, ErrNegativeLength) {
+		t.Fatalf("Float64s(-1) err=%v want ErrNegativeLength", err)
+	}
+	out, err := gen.Uint64s(0)
+	if err != nil || len(out) != 0 {
+		t.Fatalf("Uint64s(0)=%v,%v want empty", out, err)
+	}
+	failing := New(testutil.ErrReader{Err: io.ErrUnexpectedEOF})
+	if _, err := failing.Float64s(3); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Float64s err=%v want io.ErrUnexpectedEOF", err)
+	}
+}
@@ -37,3 +37,45 @@ func BenchmarkBytes16(b *testing.B) {
 		_, _ = gen.Bytes(16)
 	}
 }
+
+func BenchmarkUint64s1024(b *testing.B) {
+	gen := New(&seqSource{})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = gen.Uint64s(1024)
+	}
+}
+
+func BenchmarkShardedSourceParallel(b *testing.B) {
+	src, err := NewShardedSource(0, func() (Source, error) {
+		return &seqSource{}, nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	gen := New(src)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = gen.Uint64()
+		}
+	})
+}
+
+func BenchmarkIntRange(b *testing.B) {
+	gen := New(&seqSource{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = gen.IntRange(-1000, 1000)
+	}
+}
+
+func BenchmarkInt64RangeFull(b *testing.B) {
+	gen := New(&seqSource{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = gen.Int64Range(minInt64, maxInt64)
+	}
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"errors"
+	"io"
+)
+
+// FailoverEvent describes a read that moved from one chained source to the
+// next.
+type FailoverEvent struct {
+	// From is the argument position of the source that failed, with primary
+	// at 0 and fallbacks numbered from 1.
+	From int
+	// To is the argument position of the source that serves the rest of the
+	// read.
+	To int
+	// Err is the error returned by the failed source.
+	Err error
+}
+
+type chainSource struct {
+	srcs       []Source
+	pos        []int // argument position of each entry in srcs
+	onFailover func(FailoverEvent)
+}
+
+// ChainSources returns a Source that reads from primary and fails over to
+// each fallback in order when a source returns an error. Every read starts at
+// primary again, so an intermittently failing source is used as soon as it
+// recovers. Bytes already read from a failing source are kept and the rest
+// of the read is served by the next one. Nil sources are skipped.
+//
+// Parameters:
+//   - primary: The preferred source.
+//   - fallbacks: Sources tried in order when earlier ones fail.
+//
+// Returns:
+//   - Source: The chained source.
+//   - error: ErrNilSource if every source is nil.
+func ChainSources(primary Source, fallbacks ...Source) (Source, error) {
+	return ChainSourcesWithFailover(nil, primary, fallbacks...)
+}
+
+// ChainSourcesWithFailover is like ChainSources but calls onFailover each
+// time a read moves to the next source. onFailover may be nil.
+//
+// Parameters:
+//   - onFailover: Called synchronously on each failover.
+//   - primary: The preferred source.
+//   - fallbacks: Sources tried in order when earlier ones fail.
+//
+// Returns:
+//   - Source: The chained source.
+//   - error: ErrNilSource if every source is nil.
+func ChainSourcesWithFailover(
+	onFailover func(FailoverEvent), primary Source, fallbacks ...Source,
+) (Source, error) {
+	c := &chainSource{onFailover: onFailover}
+	for i, src := range append([]Source{primary}, fallbacks...) {
+		if src != nil {
+			c.srcs = append(c.srcs, src)
+			c.pos = append(c.pos, i)
+		}
+	}
+	if len(c.srcs) == 0 {
+		return nil, ErrNilSource
+	}
+	return c, nil
+}
+
+func (c *chainSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var (
+		total int
+		errs  []error
+	)
+	for i, src := range c.srcs {
+		n, err := io.ReadFull(src, p[total:])
+		total += n
+		if err == nil {
+			return total, nil
+		}
+		errs = append(errs, err)
+		if i+1 < len(c.srcs) && c.onFailover != nil {
+			c.onFailover(FailoverEvent{From: c.pos[i], To: c.pos[i+1], Err: err})
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+func (c *chainSource) Close() error {
+	var errs []error
+	for _, src := range c.srcs {
+		if closer, ok := src.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type partialReader struct {
+	data []byte
+	err  error
+}
+
+func (r *partialReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestChainSourcesUsesPrimary(t *testing.T) {
+	src, err := ChainSources(testutil.NewSeqReader([]byte{7}), testutil.NewSeqReader([]byte{9}))
+	if err != nil {
+		t.Fatalf("ChainSources error: %v", err)
+	}
+	buf := make([]byte, 3)
+	if _, err := src.Read(buf); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	for i, b := range buf {
+		if b != 7 {
+			t.Fatalf("buf[%d]=%d want 7", i, b)
+		}
+	}
+}
+
+func TestChainSourcesFailsOver(t *testing.T) {
+	var events []FailoverEvent
+	primary := &partialReader{data: []byte{1, 2}, err: io.ErrUnexpectedEOF}
+	src, err := ChainSourcesWithFailover(func(ev FailoverEvent) {
+		events = append(events, ev)
+	}, primary, nil, testutil.NewSeqReader([]byte{9}))
+	if err != nil {
+		t.Fatalf("ChainSourcesWithFailover error: %v", err)
+	}
+	buf := make([]byte, 4)
+	n, err := src.Read(buf)
+	if err != nil || n != 4 {
+		t.Fatalf("Read=%d,%v want 4,nil", n, err)
+	}
+	want := []byte{1, 2, 9, 9}
+	for i := range want {
+		if buf[i] != want[i] {
+			t.Fatalf("buf=%v want %v", buf, want)
+		}
+	}
+	// The nil fallback at position 1 is skipped but positions are kept.
+	if len(events) != 1 || events[0].From != 0 || events[0].To != 2 ||
+		!errors.Is(events[0].Err, io.ErrUnexpectedEOF) {
+		t.Fatalf("events=%+v want one failover 0->2", events)
+	}
+}
+
+func TestChainSourcesAllFail(t *testing.T) {
+	src, err := ChainSources(testutil.ErrReader{Err: io.ErrUnexpectedEOF}, testutil.ErrReader{Err: io.ErrClosedPipe})
+	if err != nil {
+		t.Fatalf("ChainSources error: %v", err)
+	}
+	_, err = New(src).Uint64()
+	if !errors.Is(err, io.ErrUnexpectedEOF) || !errors.Is(err, io.ErrClosedPipe) {
+		t.Fatalf("err=%v want both source errors", err)
+	}
+	if _, err := ChainSources(nil, nil); !errors.Is(err, ErrNilSource) {
+		t.Fatalf("ChainSources(nil, nil) err=%v want ErrNilSource", err)
+	}
+}
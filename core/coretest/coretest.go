@@ -0,0 +1,195 @@
+package coretest
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// samples is the number of draws used by each bias check.
+const samples = 20000
+
+// TestRNG runs the conformance suite against rng. The checks draw a few
+// hundred kilobytes of entropy, so rng must not be a source with a tiny
+// output budget.
+//
+// Parameters:
+//   - t: The test to report failures to.
+//   - rng: The RNG under test.
+func TestRNG(t *testing.T, rng core.RNG) {
+	t.Helper()
+	t.Run("Bytes", func(t *testing.T) { testBytes(t, rng) })
+	t.Run("ArgumentErrors", func(t *testing.T) { testArgumentErrors(t, rng) })
+	t.Run("Ranges", func(t *testing.T) { testRanges(t, rng) })
+	t.Run("Float64", func(t *testing.T) { testFloat64(t, rng) })
+	t.Run("Bias", func(t *testing.T) { testBias(t, rng) })
+}
+
+// TestRNGErrorPropagation checks that every RNG method reports entropy
+// failures instead of returning silently. newRNG must build an RNG that reads
+// only from the given source.
+//
+// Parameters:
+//   - t: The test to report failures to.
+//   - newRNG: Builds the RNG under test on top of a source.
+func TestRNGErrorPropagation(t *testing.T, newRNG func(src core.Source) core.RNG) {
+	t.Helper()
+	rng := newRNG(failingSource{})
+	checks := map[string]func() error{
+		"Read":       func() error { _, err := rng.Read(make([]byte, 8)); return err },
+		"Fill":       func() error { return rng.Fill(make([]byte, 8)) },
+		"Bytes":      func() error { _, err := rng.Bytes(8); return err },
+		"Uint64":     func() error { _, err := rng.Uint64(); return err },
+		"Uint64n":    func() error { _, err := rng.Uint64n(10); return err },
+		"Intn":       func() error { _, err := rng.Intn(10); return err },
+		"Int64n":     func() error { _, err := rng.Int64n(10); return err },
+		"IntRange":   func() error { _, err := rng.IntRange(-5, 5); return err },
+		"Int32Range": func() error { _, err := rng.Int32Range(-5, 5); return err },
+		"Int64Range": func() error { _, err := rng.Int64Range(-5, 5); return err },
+		"Float64":    func() error { _, err := rng.Float64(); return err },
+		"Bool":       func() error { _, err := rng.Bool(); return err },
+	}
+	for name, check := range checks {
+		if err := check(); !errors.Is(err, errInjected) {
+			t.Errorf("%s err=%v want injected source error", name, err)
+		}
+	}
+}
+
+var errInjected = errors.New("coretest: injected source failure")
+
+type failingSource struct{}
+
+func (failingSource) Read(_ []byte) (int, error) { return 0, errInjected }
+
+func testBytes(t *testing.T, rng core.RNG) {
+	for _, n := range []int{0, 1, 7, 64} {
+		b, err := rng.Bytes(n)
+		if err != nil {
+			t.Fatalf("Bytes(%d) error: %v", n, err)
+		}
+		if len(b) != n {
+			t.Fatalf("Bytes(%d) len=%d", n, len(b))
+		}
+	}
+	buf := make([]byte, 33)
+	n, err := rng.Read(buf)
+	if err != nil || n != len(buf) {
+		t.Fatalf("Read=%d,%v want %d,nil", n, err, len(buf))
+	}
+	if err := rng.Fill(buf); err != nil {
+		t.Fatalf("Fill error: %v", err)
+	}
+}
+
+func testArgumentErrors(t *testing.T, rng core.RNG) {
+	if _, err := rng.Bytes(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Errorf("Bytes(-1) err=%v want ErrNegativeLength", err)
+	}
+	if _, err := rng.Uint64n(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Errorf("Uint64n(0) err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := rng.Intn(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Errorf("Intn(0) err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := rng.Int64n(-1); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Errorf("Int64n(-1) err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := rng.IntRange(2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Errorf("IntRange(2,1) err=%v want ErrMinGreaterThanMax", err)
+	}
+	if _, err := rng.Int32Range(2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Errorf("Int32Range(2,1) err=%v want ErrMinGreaterThanMax", err)
+	}
+	if _, err := rng.Int64Range(2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Errorf("Int64Range(2,1) err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func testRanges(t *testing.T, rng core.RNG) {
+	for i := 0; i < 1000; i++ {
+		if v, err := rng.Uint64n(3); err != nil || v >= 3 {
+			t.Fatalf("Uint64n(3)=%d,%v", v, err)
+		}
+		if v, err := rng.Intn(5); err != nil || v < 0 || v >= 5 {
+			t.Fatalf("Intn(5)=%d,%v", v, err)
+		}
+		if v, err := rng.Int64n(1 << 40); err != nil || v < 0 || v >= 1<<40 {
+			t.Fatalf("Int64n(1<<40)=%d,%v", v, err)
+		}
+		if v, err := rng.IntRange(-3, 3); err != nil || v < -3 || v > 3 {
+			t.Fatalf("IntRange(-3,3)=%d,%v", v, err)
+		}
+		if v, err := rng.Int32Range(math.MinInt32, math.MinInt32+1); err != nil ||
+			v > math.MinInt32+1 {
+			t.Fatalf("Int32Range(min,min+1)=%d,%v", v, err)
+		}
+		if v, err := rng.Int64Range(math.MaxInt64-1, math.MaxInt64); err != nil ||
+			v < math.MaxInt64-1 {
+			t.Fatalf("Int64Range(max-1,max)=%d,%v", v, err)
+		}
+	}
+	if v, err := rng.IntRange(7, 7); err != nil || v != 7 {
+		t.Fatalf("IntRange(7,7)=%d,%v want 7", v, err)
+	}
+	if _, err := rng.Int64Range(math.MinInt64, math.MaxInt64); err != nil {
+		t.Fatalf("Int64Range full range error: %v", err)
+	}
+}
+
+func testFloat64(t *testing.T, rng core.RNG) {
+	for i := 0; i < 1000; i++ {
+		v, err := rng.Float64()
+		if err != nil {
+			t.Fatalf("Float64 error: %v", err)
+		}
+		if v < 0 || v >= 1 {
+			t.Fatalf("Float64()=%v outside [0,1)", v)
+		}
+	}
+}
+
+func testBias(t *testing.T, rng core.RNG) {
+	const buckets = 7
+	assertUniform(t, "Intn(7)", buckets, func() (int, error) {
+		return rng.Intn(buckets)
+	})
+	assertUniform(t, "Bool", 2, func() (int, error) {
+		b, err := rng.Bool()
+		if b {
+			return 1, err
+		}
+		return 0, err
+	})
+	assertUniform(t, "Float64", 10, func() (int, error) {
+		f, err := rng.Float64()
+		return int(f * 10), err
+	})
+}
+
+// assertUniform fails if any bucket deviates from its expected count by more
+// than six standard deviations, which keeps false positives negligible while
+// still catching gross bias such as modulo reduction errors.
+func assertUniform(t *testing.T, name string, buckets int, draw func() (int, error)) {
+	t.Helper()
+	counts := make([]int, buckets)
+	for i := 0; i < samples; i++ {
+		v, err := draw()
+		if err != nil {
+			t.Fatalf("%s draw error: %v", name, err)
+		}
+		if v < 0 || v >= buckets {
+			t.Fatalf("%s value %d outside [0,%d)", name, v, buckets)
+		}
+		counts[v]++
+	}
+	expected := float64(samples) / float64(buckets)
+	tol := 6 * math.Sqrt(expected)
+	for i, count := range counts {
+		if math.Abs(float64(count)-expected) > tol {
+			t.Fatalf("%s bucket %d count=%d want %0.1f ± %0.1f", name, i, count, expected, tol)
+		}
+	}
+}
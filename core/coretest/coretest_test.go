@@ -0,0 +1,23 @@
+package coretest_test
+
+import (
+	"testing"
+
+	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/core/coretest"
+)
+
+func TestGeneratorConforms(t *testing.T) {
+	coretest.TestRNG(t, core.New(nil))
+}
+
+func TestLockedRNGConforms(t *testing.T) {
+	coretest.TestRNG(t, adapters.LockedRNG(core.New(nil)))
+}
+
+func TestGeneratorPropagatesErrors(t *testing.T) {
+	coretest.TestRNGErrorPropagation(t, func(src core.Source) core.RNG {
+		return core.New(src)
+	})
+}
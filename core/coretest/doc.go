@@ -0,0 +1,5 @@
+// Package coretest provides a conformance harness for core.RNG
+// implementations. Third-party adapters (for example HSM-backed RNGs) can run
+// it from their own tests to check range correctness, bias, and error
+// behavior against the contract of core.Generator.
+package coretest
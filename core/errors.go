@@ -1,6 +1,9 @@
 package core
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Package-level errors. Returned when arguments are invalid.
 var (
@@ -13,20 +16,89 @@ var (
 	ErrInvalidJitter      = errors.New("randutil: jitter must be in [0,1]")
 	ErrEmptyCharset       = errors.New("randutil: charset must be non-empty")
 	ErrInvalidCharset     = errors.New("randutil: charset must be ASCII")
+	ErrInvalidRune        = errors.New("randutil: charset contains an invalid rune")
+	ErrUnknownCharset     = errors.New("randutil: unknown charset name")
 	ErrOddHexLength       = errors.New("randutil: hex length must be even")
+	ErrUnsatisfiable      = errors.New("randutil: constraints cannot be satisfied")
+	ErrInvalidWordlist    = errors.New("randutil: wordlist contains duplicate words")
+	ErrInvalidPattern     = errors.New("randutil: pattern ends with an unfinished escape")
+	ErrInvalidChecksum    = errors.New("randutil: checksum mismatch")
 
 	ErrSampleTooLarge  = errors.New("randutil: sample size exceeds available items")
 	ErrInvalidWeights  = errors.New("randutil: weights must be non-negative with at least one > 0")
 	ErrWeightsMismatch = errors.New("randutil: items/weights length mismatch")
 	ErrEmptySlice      = errors.New("randutil: empty slice")
 	ErrEmptyItems      = errors.New("randutil: empty items")
+	ErrOddItems        = errors.New("randutil: item count must be even")
 
 	ErrMinGreaterThanMax       = errors.New("randutil: min greater than max")
 	ErrInvalidRangeNonPositive = errors.New("randutil: range must be positive")
 	ErrResultOutOfRange        = errors.New("randutil: result out of range")
+	ErrNilBound                = errors.New("randutil: bound must be non-nil")
+	ErrNonFiniteBound          = errors.New("randutil: bound must be finite")
+	ErrInvalidInterval         = errors.New("randutil: unknown interval kind")
 
+	ErrNilSource             = errors.New("randutil: source must be non-nil")
 	ErrSourceClosed          = errors.New("randutil: source closed")
 	ErrSourceExhausted       = errors.New("randutil: source exhausted")
+	ErrSeedTooShort          = errors.New("randutil: seed too short")
+	ErrInsufficientJitter    = errors.New("randutil: insufficient timing jitter")
 	ErrWorkspaceClosed       = errors.New("randutil: workspace closed")
+	ErrSnapshotUnsupported   = errors.New("randutil: source does not support snapshots")
+	ErrInvalidSnapshot       = errors.New("randutil: invalid snapshot")
+	ErrInvalidRecording      = errors.New("randutil: invalid recording")
 	ErrDeterministicDisabled = errors.New("randutil: deterministic sources disabled")
+	ErrInjectedFault         = errors.New("randutil: injected fault")
 )
+
+// RangeError reports an invalid range passed to a range operation. It wraps
+// one of the package sentinels, so errors.Is keeps working while errors.As
+// exposes the offending bounds.
+type RangeError struct {
+	// Op is the name of the operation that rejected the range.
+	Op string
+	// Min is the lower bound that was passed.
+	Min any
+	// Max is the upper bound that was passed.
+	Max any
+	// Err is the underlying sentinel error.
+	Err error
+}
+
+// Error implements error.
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%v (%s: min=%v max=%v)", e.Err, e.Op, e.Min, e.Max)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *RangeError) Unwrap() error { return e.Err }
+
+// ArgError reports an invalid scalar argument such as a bound or length. It
+// wraps one of the package sentinels, so errors.Is keeps working while
+// errors.As exposes the offending value.
+type ArgError struct {
+	// Op is the name of the operation that rejected the argument.
+	Op string
+	// Arg is the parameter name.
+	Arg string
+	// Value is the value that was passed.
+	Value any
+	// Err is the underlying sentinel error.
+	Err error
+}
+
+// Error implements error.
+func (e *ArgError) Error() string {
+	return fmt.Sprintf("%v (%s: %s=%v)", e.Err, e.Op, e.Arg, e.Value)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ArgError) Unwrap() error { return e.Err }
+
+func rangeError(op string, minV any, maxV any, err error) error {
+	return &RangeError{Op: op, Min: minV, Max: maxV, Err: err}
+}
+
+func argError(op string, arg string, value any, err error) error {
+	return &ArgError{Op: op, Arg: arg, Value: value, Err: err}
+}
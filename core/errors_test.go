@@ -0,0 +1,38 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRangeErrorCarriesBounds(t *testing.T) {
+	_, err := New(nil).IntRange(5, 1)
+	if !errors.Is(err, ErrMinGreaterThanMax) {
+		t.Fatalf("IntRange err=%v want ErrMinGreaterThanMax", err)
+	}
+	var rerr *RangeError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("IntRange err=%T want *RangeError", err)
+	}
+	if rerr.Op != "IntRange" || rerr.Min != 5 || rerr.Max != 1 {
+		t.Fatalf("RangeError=%+v want IntRange 5..1", rerr)
+	}
+	if !strings.Contains(err.Error(), "min=5 max=1") {
+		t.Fatalf("Error()=%q missing bounds", err.Error())
+	}
+}
+
+func TestArgErrorCarriesValue(t *testing.T) {
+	_, err := New(nil).Intn(-3)
+	if !errors.Is(err, ErrNonPositiveBound) {
+		t.Fatalf("Intn err=%v want ErrNonPositiveBound", err)
+	}
+	var aerr *ArgError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("Intn err=%T want *ArgError", err)
+	}
+	if aerr.Op != "Intn" || aerr.Arg != "n" || aerr.Value != -3 {
+		t.Fatalf("ArgError=%+v want Intn n=-3", aerr)
+	}
+}
@@ -0,0 +1,203 @@
+package core
+
+import "math"
+
+// Float32 returns a uniform random float32 in [0.0, 1.0) with 24 bits of
+// precision built from the generator's entropy source.
+//
+// Returns:
+//   - float32: A random float32 in [0.0, 1.0).
+//   - error: An error if entropy fails.
+func (g *Generator) Float32() (float32, error) {
+	u, err := g.Uint64()
+	if err != nil {
+		return 0, err
+	}
+	const denom = 1 << 24
+	return float32(u>>40) / float32(denom), nil
+}
+
+// Interval selects which endpoints Float64Interval and Float32Interval may
+// return.
+type Interval int
+
+const (
+	// HalfOpen is [lower, upper).
+	HalfOpen Interval = iota
+	// Closed is [lower, upper].
+	Closed
+	// Open is (lower, upper).
+	Open
+	// OpenClosed is (lower, upper].
+	OpenClosed
+)
+
+// float64Steps is the number of evenly spaced 53-bit fractions in [0, 1).
+const float64Steps = 1 << 53
+
+// Float64Range returns a uniform random float64 in the half-open interval
+// [minInclusive, maxExclusive). Results that round up to maxExclusive are
+// redrawn, so maxExclusive is never returned.
+//
+// Parameters:
+//   - minInclusive: The minimum value (inclusive).
+//   - maxExclusive: The maximum value (exclusive).
+//
+// Returns:
+//   - float64: A random float64 in [minInclusive, maxExclusive).
+//   - error: An error if a bound is not finite, minInclusive >= maxExclusive,
+//     or if entropy fails.
+func (g *Generator) Float64Range(minInclusive float64, maxExclusive float64) (float64, error) {
+	return g.float64Interval("Float64Range", minInclusive, maxExclusive, HalfOpen)
+}
+
+// Float32Range returns a uniform random float32 in the half-open interval
+// [minInclusive, maxExclusive). Results that round up to maxExclusive are
+// redrawn, so maxExclusive is never returned.
+//
+// Parameters:
+//   - minInclusive: The minimum value (inclusive).
+//   - maxExclusive: The maximum value (exclusive).
+//
+// Returns:
+//   - float32: A random float32 in [minInclusive, maxExclusive).
+//   - error: An error if a bound is not finite, minInclusive >= maxExclusive,
+//     or if entropy fails.
+func (g *Generator) Float32Range(minInclusive float32, maxExclusive float32) (float32, error) {
+	return g.float32Interval("Float32Range", minInclusive, maxExclusive, HalfOpen)
+}
+
+// Float64Interval returns a uniform random float64 between lower and upper
+// with the endpoints selected by interval. The span is halved before
+// scaling, so ranges wider than math.MaxFloat64 (for example -MaxFloat64 to
+// MaxFloat64) neither overflow nor lose the uniformity of the underlying
+// 53-bit draw; results that round outside the interval are redrawn.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float64: A random float64 in the requested interval.
+//   - error: An error if a bound is not finite, the interval is empty or
+//     unknown, or if entropy fails.
+func (g *Generator) Float64Interval(lower float64, upper float64, interval Interval) (float64, error) {
+	return g.float64Interval("Float64Interval", lower, upper, interval)
+}
+
+// Float32Interval returns a uniform random float32 between lower and upper
+// with the endpoints selected by interval. Results that round outside the
+// interval after conversion to float32 are redrawn.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float32: A random float32 in the requested interval.
+//   - error: An error if a bound is not finite, the interval is empty or
+//     unknown, or if entropy fails.
+func (g *Generator) Float32Interval(lower float32, upper float32, interval Interval) (float32, error) {
+	return g.float32Interval("Float32Interval", lower, upper, interval)
+}
+
+func (g *Generator) float64Interval(op string, lower float64, upper float64, interval Interval) (float64, error) {
+	if err := checkInterval(op, lower, upper, interval); err != nil {
+		return 0, err
+	}
+	if lower == upper {
+		return lower, nil
+	}
+	// Halving keeps the span finite for ranges wider than MaxFloat64.
+	half := upper/2 - lower/2
+	for {
+		u, err := g.unitFraction(interval)
+		if err != nil {
+			return 0, err
+		}
+		v := lower + half*u + half*u
+		if interval.contains(lower, upper, v) {
+			return v, nil
+		}
+	}
+}
+
+func (g *Generator) float32Interval(op string, lower float32, upper float32, interval Interval) (float32, error) {
+	lo, hi := float64(lower), float64(upper)
+	if err := checkInterval(op, lo, hi, interval); err != nil {
+		return 0, err
+	}
+	if interval == Open && math.Nextafter32(lower, upper) >= upper {
+		return 0, rangeError(op, lower, upper, ErrInvalidRangeNonPositive)
+	}
+	if lower == upper {
+		return lower, nil
+	}
+	for {
+		u, err := g.unitFraction(interval)
+		if err != nil {
+			return 0, err
+		}
+		v := float32(lo + (hi-lo)*u)
+		if interval.contains(lo, hi, float64(v)) {
+			return v, nil
+		}
+	}
+}
+
+// unitFraction returns k/2^53 for a uniform k chosen so that 0 and 1 are
+// included exactly when interval includes the matching endpoint. The
+// half-open case is Float64, so Float64Range keeps its output stream.
+func (g *Generator) unitFraction(interval Interval) (float64, error) {
+	if interval == HalfOpen {
+		return g.Float64()
+	}
+	lo, hi := uint64(0), uint64(float64Steps-1)
+	if interval == Open || interval == OpenClosed {
+		lo = 1
+	}
+	if interval == Closed || interval == OpenClosed {
+		hi = float64Steps
+	}
+	k, err := g.Uint64n(hi - lo + 1)
+	if err != nil {
+		return 0, err
+	}
+	return float64(lo+k) / float64Steps, nil
+}
+
+func (i Interval) contains(lower float64, upper float64, v float64) bool {
+	switch i {
+	case Closed:
+		return v >= lower && v <= upper
+	case Open:
+		return v > lower && v < upper
+	case OpenClosed:
+		return v > lower && v <= upper
+	default:
+		return v >= lower && v < upper
+	}
+}
+
+func checkInterval(op string, lower float64, upper float64, interval Interval) error {
+	if math.IsNaN(lower) || math.IsNaN(upper) || math.IsInf(lower, 0) || math.IsInf(upper, 0) {
+		return rangeError(op, lower, upper, ErrNonFiniteBound)
+	}
+	if lower > upper {
+		return rangeError(op, lower, upper, ErrMinGreaterThanMax)
+	}
+	if interval < HalfOpen || interval > OpenClosed {
+		return rangeError(op, lower, upper, ErrInvalidInterval)
+	}
+	empty := lower == upper && interval != Closed
+	if interval == Open && math.Nextafter(lower, upper) >= upper {
+		// No float64 lies strictly between adjacent bounds.
+		empty = true
+	}
+	if empty {
+		return rangeError(op, lower, upper, ErrInvalidRangeNonPositive)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestFloat32UnitInterval(t *testing.T) {
+	gen := New(testutil.NewSeqReader(testutil.Uint64Bytes(^uint64(0))))
+	v, err := gen.Float32()
+	if err != nil {
+		t.Fatalf("Float32 error: %v", err)
+	}
+	if v < 0 || v >= 1 {
+		t.Fatalf("Float32()=%v outside [0,1)", v)
+	}
+}
+
+func TestFloat64RangeExcludesMax(t *testing.T) {
+	// The largest Float64 draw rounds 1 + (2-1)*u up to 2; it must be redrawn.
+	gen := New(testutil.NewSeqReader(
+		testutil.Uint64Bytes(^uint64(0)),
+		testutil.Float64Bytes(0.5),
+	))
+	v, err := gen.Float64Range(1e16, 1e16+2)
+	if err != nil {
+		t.Fatalf("Float64Range error: %v", err)
+	}
+	if v >= 1e16+2 {
+		t.Fatalf("Float64Range returned max %v", v)
+	}
+}
+
+func TestFloat64RangeWideSpan(t *testing.T) {
+	gen := New(nil)
+	for i := 0; i < 100; i++ {
+		v, err := gen.Float64Range(-math.MaxFloat64, math.MaxFloat64)
+		if err != nil {
+			t.Fatalf("Float64Range error: %v", err)
+		}
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			t.Fatalf("Float64Range returned %v", v)
+		}
+	}
+}
+
+func TestFloat32RangeBounds(t *testing.T) {
+	gen := New(nil)
+	for i := 0; i < 1000; i++ {
+		v, err := gen.Float32Range(-1.5, 2.5)
+		if err != nil {
+			t.Fatalf("Float32Range error: %v", err)
+		}
+		if v < -1.5 || v >= 2.5 {
+			t.Fatalf("Float32Range()=%v outside [-1.5,2.5)", v)
+		}
+	}
+}
+
+func TestFloatRangeErrors(t *testing.T) {
+	gen := New(nil)
+	tests := []struct {
+		lo, hi float64
+		want   error
+	}{
+		{1, 0, ErrMinGreaterThanMax},
+		{1, 1, ErrInvalidRangeNonPositive},
+		{math.NaN(), 1, ErrNonFiniteBound},
+		{0, math.Inf(1), ErrNonFiniteBound},
+	}
+	for _, tc := range tests {
+		if _, err := gen.Float64Range(tc.lo, tc.hi); !errors.Is(err, tc.want) {
+			t.Fatalf("Float64Range(%v,%v) err=%v want %v", tc.lo, tc.hi, err, tc.want)
+		}
+		if _, err := gen.Float32Range(float32(tc.lo), float32(tc.hi)); !errors.Is(err, tc.want) {
+			t.Fatalf("Float32Range(%v,%v) err=%v want %v", tc.lo, tc.hi, err, tc.want)
+		}
+	}
+}
+
+func TestFloat64IntervalEndpoints(t *testing.T) {
+	const steps = 1 << 53
+	cases := []struct {
+		interval Interval
+		k        uint64 // first Uint64n draw, or Float64 bits for HalfOpen
+		want     float64
+	}{
+		{HalfOpen, 0, -1},
+		{Closed, 0, -1},
+		{Closed, steps, 1},
+		{Open, 0, -1 + 2.0/steps},
+		{OpenClosed, steps - 1, 1},
+	}
+	for _, tc := range cases {
+		gen := New(testutil.NewSeqReader(testutil.Uint64Bytes(tc.k)))
+		got, err := gen.Float64Interval(-1, 1, tc.interval)
+		if err != nil || got != tc.want {
+			t.Fatalf("interval %d k=%d: got %v err %v want %v", tc.interval, tc.k, got, err, tc.want)
+		}
+	}
+}
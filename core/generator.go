@@ -17,14 +17,15 @@ const (
 )
 
 // Generator builds numbers and bytes using an entropy source.
-// Zero-value uses crypto/rand.Reader.
+// Zero-value uses the default source (see New).
 //
 // Concurrency: safe for concurrent use if the underlying Source is safe.
 type Generator struct {
 	src Source
 }
 
-// New returns a core Generator. If src is nil, crypto/rand.Reader is used.
+// New returns a core Generator. If src is nil, crypto/rand.Reader is used, or
+// the sharded default source while EnableShardedSource is in effect.
 //
 // Parameters:
 //   - src: The entropy source to use.
@@ -32,20 +33,20 @@ type Generator struct {
 // Returns:
 //   - *Generator: A new core Generator.
 func New(src Source) *Generator {
-	if src == nil {
-		src = crand.Reader
-	}
 	return &Generator{src: src}
 }
 
 func (g *Generator) source() Source {
 	if g == nil || g.src == nil {
+		if src := defaultSource.Load(); src != nil {
+			return *src
+		}
 		return crand.Reader
 	}
 	return g.src
 }
 
-// Source returns the underlying entropy source (or crypto/rand.Reader).
+// Source returns the underlying entropy source (or the default source).
 //
 // Returns:
 //   - Source: The configured entropy source.
@@ -77,7 +78,7 @@ func (g *Generator) Read(p []byte) (int, error) {
 	if len(p) == 0 {
 		return 0, nil
 	}
-	return io.ReadFull(g.source(), p)
+	return g.readFull(p)
 }
 
 // Bytes returns n random bytes from the generator's entropy source.
@@ -90,7 +91,7 @@ func (g *Generator) Read(p []byte) (int, error) {
 //   - error: An error if n < 0 or if entropy fails.
 func (g *Generator) Bytes(n int) ([]byte, error) {
 	if n < 0 {
-		return nil, ErrNegativeLength
+		return nil, argError("Bytes", "n", n, ErrNegativeLength)
 	}
 	buf := make([]byte, n)
 	if err := g.Fill(buf); err != nil {
@@ -110,7 +111,7 @@ func (g *Generator) Fill(b []byte) error {
 	if len(b) == 0 {
 		return nil
 	}
-	_, err := io.ReadFull(g.source(), b)
+	_, err := g.readFull(b)
 	if err != nil {
 		for i := range b {
 			b[i] = 0
@@ -143,7 +144,7 @@ func (g *Generator) Uint64() (uint64, error) {
 //   - error: An error if n == 0 or if entropy fails.
 func (g *Generator) Uint64n(n uint64) (uint64, error) {
 	if n == 0 {
-		return 0, ErrNonPositiveBound
+		return 0, argError("Uint64n", "n", n, ErrNonPositiveBound)
 	}
 	var (
 		maxUint = ^uint64(0)
@@ -170,7 +171,7 @@ func (g *Generator) Uint64n(n uint64) (uint64, error) {
 //   - error: An error if n <= 0 or if entropy fails.
 func (g *Generator) Intn(n int) (int, error) {
 	if n <= 0 {
-		return 0, ErrNonPositiveBound
+		return 0, argError("Intn", "n", n, ErrNonPositiveBound)
 	}
 	u, err := g.Uint64n(uint64(n))
 	if err != nil {
@@ -192,7 +193,7 @@ func (g *Generator) Intn(n int) (int, error) {
 //   - error: An error if n <= 0 or if entropy fails.
 func (g *Generator) Int64n(n int64) (int64, error) {
 	if n <= 0 {
-		return 0, ErrNonPositiveBound
+		return 0, argError("Int64n", "n", n, ErrNonPositiveBound)
 	}
 	u, err := g.Uint64n(uint64(n))
 	if err != nil {
@@ -244,40 +245,13 @@ func (g *Generator) Bool() (bool, error) {
 //   - error: An error if minInclusive > maxInclusive or if entropy fails.
 func (g *Generator) IntRange(minInclusive int, maxInclusive int) (int, error) {
 	if minInclusive > maxInclusive {
-		return 0, ErrMinGreaterThanMax
-	}
-	min64 := int64(minInclusive)
-	max64 := int64(maxInclusive)
-	if span, ok := spanInt64(min64, max64); ok && span > 0 &&
-		span <= uint64(maxInt64)+1 {
-		u64, err := g.Uint64n(span)
-		if err != nil {
-			return 0, err
-		}
-		u, err := uint64ToInt64(u64)
-		if err != nil {
-			return 0, err
-		}
-		return int64ToInt(min64 + u)
-	}
-
-	bigMin := big.NewInt(min64)
-	bigMax := big.NewInt(max64)
-	diff := new(big.Int).Sub(bigMax, bigMin)
-	diff.Add(diff, big.NewInt(1))
-	if diff.Sign() <= 0 {
-		return 0, ErrInvalidRangeNonPositive
+		return 0, rangeError("IntRange", minInclusive, maxInclusive, ErrMinGreaterThanMax)
 	}
-	n, err := g.bigInt(diff)
+	v, err := g.int64Range(int64(minInclusive), int64(maxInclusive))
 	if err != nil {
 		return 0, err
 	}
-	res := new(big.Int).Add(n, bigMin)
-	i64, err := bigIntToInt64(res)
-	if err != nil {
-		return 0, err
-	}
-	return int64ToInt(i64)
+	return int64ToInt(v)
 }
 
 // Int32Range returns a secure random int32 in [minInclusive, maxInclusive].
@@ -291,7 +265,7 @@ func (g *Generator) IntRange(minInclusive int, maxInclusive int) (int, error) {
 //   - error: An error if minInclusive > maxInclusive or if entropy fails.
 func (g *Generator) Int32Range(minInclusive int32, maxInclusive int32) (int32, error) {
 	if minInclusive > maxInclusive {
-		return 0, ErrMinGreaterThanMax
+		return 0, rangeError("Int32Range", minInclusive, maxInclusive, ErrMinGreaterThanMax)
 	}
 	diff := int64(maxInclusive) - int64(minInclusive) + 1
 	if diff <= 0 {
@@ -319,43 +293,36 @@ func (g *Generator) Int32Range(minInclusive int32, maxInclusive int32) (int32, e
 //   - error: An error if minInclusive > maxInclusive or if entropy fails.
 func (g *Generator) Int64Range(minInclusive int64, maxInclusive int64) (int64, error) {
 	if minInclusive > maxInclusive {
-		return 0, ErrMinGreaterThanMax
-	}
-	if span, ok := spanInt64(minInclusive, maxInclusive); ok && span > 0 &&
-		span <= uint64(maxInt64)+1 {
-		u64, err := g.Uint64n(span)
-		if err != nil {
-			return 0, err
-		}
-		u, err := uint64ToInt64(u64)
-		if err != nil {
-			return 0, err
-		}
-		res := minInclusive + u
-		if res < minInclusive || res > maxInclusive {
-			return 0, ErrResultOutOfRange
-		}
-		return res, nil
+		return 0, rangeError("Int64Range", minInclusive, maxInclusive, ErrMinGreaterThanMax)
 	}
+	return g.int64Range(minInclusive, maxInclusive)
+}
 
-	bigMin := big.NewInt(minInclusive)
-	bigMax := big.NewInt(maxInclusive)
-	bigDiff := new(big.Int).Sub(bigMax, bigMin)
-	bigDiff.Add(bigDiff, big.NewInt(1))
-	if bigDiff.Sign() <= 0 {
-		return 0, ErrInvalidRangeNonPositive
+// int64Range draws from [minInclusive, maxInclusive] without allocating.
+// Every span fits in uint64 except the full int64 range, which is served by
+// a single Uint64 draw. Callers validate the bounds.
+func (g *Generator) int64Range(minInclusive int64, maxInclusive int64) (int64, error) {
+	span, ok := spanInt64(minInclusive, maxInclusive)
+	var (
+		u   uint64
+		err error
+	)
+	if ok {
+		u, err = g.Uint64n(span)
+	} else {
+		u, err = g.Uint64()
 	}
-	n, err := g.bigInt(bigDiff)
 	if err != nil {
 		return 0, err
 	}
-	bigResult := new(big.Int).Add(n, bigMin)
-	return bigIntToInt64(bigResult)
+	// #nosec G115 -- two's complement wrap-around; minInclusive+u lies in
+	// [minInclusive, maxInclusive].
+	return int64(uint64(minInclusive) + u), nil
 }
 
 // bigInt returns a random big.Int in [0, max) using the generator's source.
 func (g *Generator) bigInt(upper *big.Int) (*big.Int, error) {
-	return crand.Int(g.source(), upper)
+	return crand.Int(g, upper)
 }
 
 func spanInt64(minInclusive int64, maxInclusive int64) (uint64, bool) {
@@ -404,13 +371,6 @@ func absInt64ToUint64(v int64) uint64 {
 	return uint64(^v) + 1
 }
 
-func bigIntToInt64(n *big.Int) (int64, error) {
-	if n.Cmp(big.NewInt(minInt64)) < 0 || n.Cmp(big.NewInt(maxInt64)) > 0 {
-		return 0, ErrResultOutOfRange
-	}
-	return n.Int64(), nil
-}
-
 func uint64ToInt64(n uint64) (int64, error) {
 	if n > uint64(maxInt64) {
 		return 0, ErrResultOutOfRange
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// ReadEvent describes one entropy read performed by a Generator.
+type ReadEvent struct {
+	// N is the number of bytes read.
+	N int
+	// Err is the error returned by the source, if any.
+	Err error
+	// Duration is the wall-clock time spent in the read.
+	Duration time.Duration
+}
+
+var readHook atomic.Pointer[func(ReadEvent)]
+
+// OnRead installs a process-wide hook that is called after every entropy read
+// made by a Generator, so applications can export bytes consumed, failures,
+// and latency to their metrics system. Passing nil removes the hook. The hook
+// runs synchronously on the reading goroutine and must be safe for concurrent
+// use; when no hook is installed reads are not timed.
+//
+// Parameters:
+//   - hook: The function to call after each read, or nil.
+func OnRead(hook func(ReadEvent)) {
+	if hook == nil {
+		readHook.Store(nil)
+		return
+	}
+	readHook.Store(&hook)
+}
+
+// readFull reads len(p) bytes from the source and reports the read to the
+// installed hook.
+func (g *Generator) readFull(p []byte) (int, error) {
+	hook := readHook.Load()
+	if hook == nil {
+		return io.ReadFull(g.source(), p)
+	}
+	start := time.Now()
+	n, err := io.ReadFull(g.source(), p)
+	(*hook)(ReadEvent{N: n, Err: err, Duration: time.Since(start)})
+	return n, err
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestOnReadReportsReads(t *testing.T) {
+	var events []ReadEvent
+	OnRead(func(ev ReadEvent) { events = append(events, ev) })
+	t.Cleanup(func() { OnRead(nil) })
+
+	gen := New(testutil.NewSeqReader([]byte{1, 2, 3}))
+	if _, err := gen.Uint64(); err != nil {
+		t.Fatalf("Uint64 error: %v", err)
+	}
+	if _, err := gen.BigIntRange(big.NewInt(0), big.NewInt(1000)); err != nil {
+		t.Fatalf("BigIntRange error: %v", err)
+	}
+	if len(events) < 2 {
+		t.Fatalf("hook saw %d events want >= 2", len(events))
+	}
+	if events[0].N != 8 || events[0].Err != nil {
+		t.Fatalf("first event=%+v want 8 bytes, nil error", events[0])
+	}
+
+	failing := New(testutil.ErrReader{Err: io.ErrUnexpectedEOF})
+	_, _ = failing.Bytes(4)
+	last := events[len(events)-1]
+	if !errors.Is(last.Err, io.ErrUnexpectedEOF) {
+		t.Fatalf("failure event err=%v want io.ErrUnexpectedEOF", last.Err)
+	}
+}
+
+func TestOnReadNilRemovesHook(t *testing.T) {
+	calls := 0
+	OnRead(func(ReadEvent) { calls++ })
+	OnRead(nil)
+	if _, err := New(testutil.NewSeqReader()).Uint64(); err != nil {
+		t.Fatalf("Uint64 error: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("removed hook called %d times", calls)
+	}
+}
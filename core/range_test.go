@@ -27,3 +27,15 @@ func TestIntRangeFullRangeCanReturnMinInt(t *testing.T) {
 		t.Fatalf("IntRange full range = %d want %d", got, minInt)
 	}
 }
+
+func TestInt64RangeFullRangeAvoidsBigInt(t *testing.T) {
+	gen := New(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	// The only allocation left is the 8-byte read buffer escaping through
+	// the Source interface.
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = gen.Int64Range(minInt64, maxInt64)
+	})
+	if allocs > 1 {
+		t.Fatalf("Int64Range full range allocs=%v want <= 1", allocs)
+	}
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+type rateLimitedSource struct {
+	mu     sync.Mutex
+	src    Source
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+	sleep  func(time.Duration)
+}
+
+// RateLimitedSource wraps src so that reads consume at most bytesPerSec bytes
+// per second on average, with bursts of up to one second's budget. Callers
+// that exceed the budget block until tokens are available, which surfaces
+// backpressure instead of starving other consumers of a scarce device. A
+// single Read returns at most bytesPerSec bytes; io.ReadFull (used by
+// Generator) keeps reading until the request is satisfied. If bytesPerSec
+// <= 0, src is returned unchanged.
+//
+// Parameters:
+//   - src: The source to limit.
+//   - bytesPerSec: The sustained read rate in bytes per second.
+//
+// Returns:
+//   - Source: The rate-limited source.
+//   - error: ErrNilSource if src is nil.
+func RateLimitedSource(src Source, bytesPerSec int) (Source, error) {
+	if src == nil {
+		return nil, ErrNilSource
+	}
+	if bytesPerSec <= 0 {
+		return src, nil
+	}
+	return &rateLimitedSource{
+		src:    src,
+		rate:   float64(bytesPerSec),
+		burst:  bytesPerSec,
+		tokens: float64(bytesPerSec),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}, nil
+}
+
+func (r *rateLimitedSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if len(p) > r.burst {
+		p = p[:r.burst]
+	}
+	r.sleep(r.reserve(len(p)))
+	n, err := r.src.Read(p)
+	if n < len(p) {
+		r.refund(len(p) - n)
+	}
+	return n, err
+}
+
+// reserve takes n tokens from the bucket, letting the balance go negative so
+// concurrent readers queue behind each other, and returns how long the
+// caller must wait before its reservation is covered.
+func (r *rateLimitedSource) reserve(n int) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if !r.last.IsZero() {
+		r.tokens += now.Sub(r.last).Seconds() * r.rate
+		if r.tokens > float64(r.burst) {
+			r.tokens = float64(r.burst)
+		}
+	}
+	r.last = now
+	r.tokens -= float64(n)
+	if r.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-r.tokens / r.rate * float64(time.Second))
+}
+
+// refund returns n reserved tokens that a short or failed read did not use,
+// so the sustained rate still matches bytesPerSec.
+func (r *rateLimitedSource) refund(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens += float64(n)
+	if r.tokens > float64(r.burst) {
+		r.tokens = float64(r.burst)
+	}
+}
+
+func (r *rateLimitedSource) Close() error {
+	if closer, ok := r.src.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
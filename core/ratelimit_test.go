@@ -0,0 +1,82 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestRateLimitedSourceBlocksOverBudget(t *testing.T) {
+	clock := time.Unix(0, 0)
+	var slept time.Duration
+	limited, err := RateLimitedSource(testutil.NewSeqReader([]byte{1}), 100)
+	if err != nil {
+		t.Fatalf("RateLimitedSource error: %v", err)
+	}
+	src := limited.(*rateLimitedSource)
+	src.now = func() time.Time { return clock }
+	src.sleep = func(d time.Duration) {
+		slept += d
+		clock = clock.Add(d)
+	}
+
+	gen := New(src)
+	if _, err := gen.Bytes(100); err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+	if slept != 0 {
+		t.Fatalf("initial burst slept %v want 0", slept)
+	}
+	if _, err := gen.Bytes(250); err != nil {
+		t.Fatalf("Bytes error: %v", err)
+	}
+	if want := 2500 * time.Millisecond; slept != want {
+		t.Fatalf("slept %v want %v", slept, want)
+	}
+}
+
+func TestRateLimitedSourceRefundsShortReads(t *testing.T) {
+	clock := time.Unix(0, 0)
+	var slept time.Duration
+	inner := &partialReader{data: make([]byte, 30), err: io.ErrUnexpectedEOF}
+	limited, err := RateLimitedSource(inner, 100)
+	if err != nil {
+		t.Fatalf("RateLimitedSource error: %v", err)
+	}
+	src := limited.(*rateLimitedSource)
+	src.now = func() time.Time { return clock }
+	src.sleep = func(d time.Duration) {
+		slept += d
+		clock = clock.Add(d)
+	}
+
+	buf := make([]byte, 100)
+	if n, err := src.Read(buf); n != 30 || err != nil {
+		t.Fatalf("Read = %d, %v want 30, nil", n, err)
+	}
+	if n, err := src.Read(buf[:10]); n != 0 || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Read = %d, %v want 0, ErrUnexpectedEOF", n, err)
+	}
+	// Only the 30 bytes actually read count against the budget, so a
+	// further 70-byte read still fits in the initial burst.
+	inner.data = make([]byte, 70)
+	if n, err := src.Read(buf[:70]); n != 70 || err != nil {
+		t.Fatalf("Read = %d, %v want 70, nil", n, err)
+	}
+	if slept != 0 {
+		t.Fatalf("slept %v want 0", slept)
+	}
+}
+
+func TestRateLimitedSourcePassThrough(t *testing.T) {
+	inner := testutil.NewSeqReader()
+	if got, err := RateLimitedSource(inner, 0); err != nil || got != Source(inner) {
+		t.Fatalf("RateLimitedSource(src, 0) = %v, %v want src", got, err)
+	}
+	if _, err := RateLimitedSource(nil, 10); !errors.Is(err, ErrNilSource) {
+		t.Fatalf("RateLimitedSource(nil) err=%v want ErrNilSource", err)
+	}
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type sourceShard struct {
+	mu  sync.Mutex
+	src Source
+	_   [40]byte // pad to reduce false sharing between adjacent shards
+}
+
+type shardedSource struct {
+	// hints caches shard indexes per P, so a goroutine usually reuses the
+	// shard of the processor it runs on without touching shared state.
+	hints  sync.Pool
+	next   atomic.Uint64 // only advanced when hints is empty
+	shards []sourceShard
+}
+
+// NewShardedSource returns a Source that spreads reads across n independent
+// shards built by newShard. Each shard is serialized by its own mutex and
+// reads prefer the shard last used on the calling processor, so parallel
+// callers mostly take different locks and share no counter. If n <= 0,
+// runtime.GOMAXPROCS(0) shards are used.
+//
+// Shards produce independent streams; the combined output is not a
+// deterministic sequence even when each shard is deterministic. A typical
+// factory is adapters.FastSource.
+//
+// Parameters:
+//   - n: The number of shards.
+//   - newShard: The factory used to build each shard.
+//
+// Returns:
+//   - Source: A Source that closes every shard on Close.
+//   - error: An error if newShard is nil or fails.
+func NewShardedSource(n int, newShard func() (Source, error)) (Source, error) {
+	if newShard == nil {
+		return nil, ErrNilSource
+	}
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	s := &shardedSource{shards: make([]sourceShard, n)}
+	s.hints.New = func() any {
+		idx := int((s.next.Add(1) - 1) % uint64(len(s.shards)))
+		return &idx
+	}
+	for i := range s.shards {
+		src, err := newShard()
+		if err == nil && src == nil {
+			err = ErrNilSource
+		}
+		if err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+		s.shards[i].src = src
+	}
+	return s, nil
+}
+
+func (s *shardedSource) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	hint := s.hints.Get().(*int)
+	defer s.hints.Put(hint)
+	shard := &s.shards[*hint]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if shard.src == nil {
+		return 0, ErrSourceClosed
+	}
+	return shard.src.Read(p)
+}
+
+func (s *shardedSource) Close() error {
+	var errs []error
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.mu.Lock()
+		src := shard.src
+		shard.src = nil
+		shard.mu.Unlock()
+		if closer, ok := src.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var defaultSource atomic.Pointer[Source]
+
+// EnableShardedSource makes every Generator created with a nil Source,
+// including the Default generators of all randutil packages, read from n
+// shards instead of crypto/rand.Reader. Each shard is a ChaCha8 stream keyed
+// from crypto/rand, so heavily parallel callers stop contending on a single
+// source. If n <= 0, runtime.GOMAXPROCS(0) shards are used. Calling it again
+// replaces the sharded source.
+//
+// Parameters:
+//   - n: The number of shards.
+//
+// Returns:
+//   - error: An error if keying a shard from crypto/rand fails.
+func EnableShardedSource(n int) error {
+	src, err := NewShardedSource(n, newChaCha8Shard)
+	if err != nil {
+		return err
+	}
+	defaultSource.Store(&src)
+	return nil
+}
+
+// DisableShardedSource restores crypto/rand.Reader as the source of
+// Generators created with a nil Source.
+func DisableShardedSource() {
+	defaultSource.Store(nil)
+}
+
+// ShardedSourceEnabled reports whether EnableShardedSource is in effect.
+func ShardedSourceEnabled() bool {
+	return defaultSource.Load() != nil
+}
+
+func newChaCha8Shard() (Source, error) {
+	var seed [32]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		return nil, err
+	}
+	return rand.NewChaCha8(seed), nil
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type closeTracker struct {
+	Source
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestShardedSourceSpreadsConcurrentReaders(t *testing.T) {
+	var shards []*countingReader
+	src, err := NewShardedSource(3, func() (Source, error) {
+		c := &countingReader{src: testutil.NewSeqReader([]byte{byte(len(shards))})}
+		shards = append(shards, c)
+		return c, nil
+	})
+	if err != nil {
+		t.Fatalf("NewShardedSource error: %v", err)
+	}
+	s := src.(*shardedSource)
+	// Hints held at the same time stand in for concurrent readers; each one
+	// must be routed to a different shard.
+	for i := 0; i < 3; i++ {
+		hint := s.hints.Get().(*int)
+		if *hint != i {
+			t.Fatalf("hint %d = shard %d want %d", i, *hint, i)
+		}
+	}
+	buf := make([]byte, 1)
+	for i := 0; i < 6; i++ {
+		if _, err := src.Read(buf); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		if int(buf[0]) >= len(shards) {
+			t.Fatalf("read served by unknown shard %d", buf[0])
+		}
+	}
+}
+
+func TestShardedSourceConcurrentAndClose(t *testing.T) {
+	var trackers []*closeTracker
+	var mu sync.Mutex
+	src, err := NewShardedSource(0, func() (Source, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		c := &closeTracker{Source: testutil.NewSeqReader([]byte{1, 2, 3})}
+		trackers = append(trackers, c)
+		return c, nil
+	})
+	if err != nil {
+		t.Fatalf("NewShardedSource error: %v", err)
+	}
+	gen := New(src)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := gen.Uint64(); err != nil {
+					t.Errorf("Uint64 error: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if err := gen.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	for i, c := range trackers {
+		if !c.closed {
+			t.Fatalf("shard %d not closed", i)
+		}
+	}
+	if _, err := src.Read(make([]byte, 1)); !errors.Is(err, ErrSourceClosed) {
+		t.Fatalf("Read after Close err=%v want ErrSourceClosed", err)
+	}
+}
+
+func TestShardedSourceErrors(t *testing.T) {
+	if _, err := NewShardedSource(2, nil); !errors.Is(err, ErrNilSource) {
+		t.Fatalf("nil factory err=%v want ErrNilSource", err)
+	}
+	calls := 0
+	_, err := NewShardedSource(2, func() (Source, error) {
+		calls++
+		if calls == 2 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return testutil.NewSeqReader(), nil
+	})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("factory error err=%v want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestEnableShardedSource(t *testing.T) {
+	defer DisableShardedSource()
+	if err := EnableShardedSource(4); err != nil {
+		t.Fatalf("EnableShardedSource error: %v", err)
+	}
+	if !ShardedSourceEnabled() {
+		t.Fatalf("ShardedSourceEnabled = false after enable")
+	}
+	gen := New(nil)
+	s, ok := gen.Source().(*shardedSource)
+	if !ok {
+		t.Fatalf("default source is %T want *shardedSource", gen.Source())
+	}
+	if len(s.shards) != 4 {
+		t.Fatalf("shards = %d want 4", len(s.shards))
+	}
+	a, err := gen.Uint64()
+	if err != nil {
+		t.Fatalf("Uint64 error: %v", err)
+	}
+	b, err := gen.Uint64()
+	if err != nil {
+		t.Fatalf("Uint64 error: %v", err)
+	}
+	if a == b {
+		t.Fatalf("sharded default repeated %d", a)
+	}
+	if err := gen.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if _, err := gen.Uint64(); err != nil {
+		t.Fatalf("Close of a nil-source Generator closed the default: %v", err)
+	}
+	DisableShardedSource()
+	if ShardedSourceEnabled() {
+		t.Fatalf("ShardedSourceEnabled = true after disable")
+	}
+	if gen.Source() != crand.Reader {
+		t.Fatalf("default source is %T after disable", gen.Source())
+	}
+}
@@ -0,0 +1,43 @@
+package core
+
+// Snapshotter is implemented by sources whose position in the output stream
+// can be captured and later restored, such as the deterministic and derived
+// sources in the adapters package.
+type Snapshotter interface {
+	// Snapshot returns an opaque encoding of the current stream state.
+	Snapshot() ([]byte, error)
+	// Restore resets the stream to a state previously returned by Snapshot.
+	Restore(state []byte) error
+}
+
+// Snapshot captures the state of the underlying source so the exact random
+// stream can be resumed later, including in another process. The snapshot
+// contains the source key material; treat it as secret if the stream is.
+//
+// Returns:
+//   - []byte: An opaque snapshot of the source state.
+//   - error: ErrSnapshotUnsupported if the source does not implement
+//     Snapshotter, or an error from the source.
+func (g *Generator) Snapshot() ([]byte, error) {
+	s, ok := g.source().(Snapshotter)
+	if !ok {
+		return nil, ErrSnapshotUnsupported
+	}
+	return s.Snapshot()
+}
+
+// Restore resets the underlying source to a state captured by Snapshot.
+//
+// Parameters:
+//   - state: A snapshot returned by Snapshot.
+//
+// Returns:
+//   - error: ErrSnapshotUnsupported if the source does not implement
+//     Snapshotter, or an error if state is invalid.
+func (g *Generator) Restore(state []byte) error {
+	s, ok := g.source().(Snapshotter)
+	if !ok {
+		return ErrSnapshotUnsupported
+	}
+	return s.Restore(state)
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestUint128Halves(t *testing.T) {
+	gen := New(testutil.NewSeqReader(testutil.Uint64Bytes(7), testutil.Uint64Bytes(9)))
+	hi, lo, err := gen.Uint128()
+	if err != nil {
+		t.Fatalf("Uint128 error: %v", err)
+	}
+	if hi != 9 || lo != 7 {
+		t.Fatalf("Uint128()=(%d,%d) want (9,7)", hi, lo)
+	}
+}
+
+func TestBigIntRangeBounds(t *testing.T) {
+	gen := New(nil)
+	minV, _ := new(big.Int).SetString("-340282366920938463463374607431768211456", 10)
+	maxV, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	minCopy := new(big.Int).Set(minV)
+	for i := 0; i < 200; i++ {
+		v, err := gen.BigIntRange(minV, maxV)
+		if err != nil {
+			t.Fatalf("BigIntRange error: %v", err)
+		}
+		if v.Cmp(minV) < 0 || v.Cmp(maxV) > 0 {
+			t.Fatalf("BigIntRange=%s outside bounds", v)
+		}
+	}
+	if minV.Cmp(minCopy) != 0 {
+		t.Fatalf("BigIntRange mutated minInclusive")
+	}
+	one := big.NewInt(5)
+	v, err := gen.BigIntRange(one, one)
+	if err != nil || v.Cmp(one) != 0 {
+		t.Fatalf("BigIntRange(5,5)=%v,%v want 5", v, err)
+	}
+	if v == one {
+		t.Fatalf("BigIntRange returned an alias of minInclusive")
+	}
+}
+
+func TestBigIntRangeErrors(t *testing.T) {
+	gen := New(nil)
+	if _, err := gen.BigIntRange(nil, big.NewInt(1)); !errors.Is(err, ErrNilBound) {
+		t.Fatalf("nil min err=%v want ErrNilBound", err)
+	}
+	if _, err := gen.BigIntRange(big.NewInt(2), big.NewInt(1)); !errors.Is(err, ErrMinGreaterThanMax) {
+		t.Fatalf("min>max err=%v want ErrMinGreaterThanMax", err)
+	}
+}
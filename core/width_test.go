@@ -0,0 +1,55 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestNarrowRangesBounds(t *testing.T) {
+	gen := New(nil)
+	for i := 0; i < 200; i++ {
+		if v, err := gen.Int8Range(-3, 4); err != nil || v < -3 || v > 4 {
+			t.Fatalf("Int8Range=%d,%v", v, err)
+		}
+		if v, err := gen.Int16Range(math.MinInt16, -100); err != nil || v > -100 {
+			t.Fatalf("Int16Range=%d,%v", v, err)
+		}
+		if v, err := gen.Uint8Range(250, 255); err != nil || v < 250 {
+			t.Fatalf("Uint8Range=%d,%v", v, err)
+		}
+		if v, err := gen.Uint16Range(10, 20); err != nil || v < 10 || v > 20 {
+			t.Fatalf("Uint16Range=%d,%v", v, err)
+		}
+		if v, err := gen.Uint32Range(1<<31, math.MaxUint32); err != nil || v < 1<<31 {
+			t.Fatalf("Uint32Range=%d,%v", v, err)
+		}
+		if v, err := gen.Uint64Range(1<<63, 1<<63+5); err != nil || v < 1<<63 || v > 1<<63+5 {
+			t.Fatalf("Uint64Range=%d,%v", v, err)
+		}
+	}
+}
+
+func TestNarrowRangesFullWidth(t *testing.T) {
+	gen := New(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if v, err := gen.Int8Range(math.MinInt8, math.MaxInt8); err != nil || v != math.MinInt8 {
+		t.Fatalf("Int8Range full=%d,%v want %d", v, err, math.MinInt8)
+	}
+	gen = New(testutil.NewSeqReader(testutil.Uint64Bytes(math.MaxUint64)))
+	if v, err := gen.Uint64Range(0, math.MaxUint64); err != nil || v != math.MaxUint64 {
+		t.Fatalf("Uint64Range full=%d,%v", v, err)
+	}
+}
+
+func TestNarrowRangesInvalid(t *testing.T) {
+	gen := New(nil)
+	if _, err := gen.Uint16Range(2, 1); !errors.Is(err, ErrMinGreaterThanMax) {
+		t.Fatalf("Uint16Range err=%v want ErrMinGreaterThanMax", err)
+	}
+	var rerr *RangeError
+	if _, err := gen.Int8Range(2, 1); !errors.As(err, &rerr) || rerr.Op != "Int8Range" {
+		t.Fatalf("Int8Range err=%v want *RangeError for Int8Range", err)
+	}
+}
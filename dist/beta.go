@@ -0,0 +1,58 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Beta returns a random value from a beta distribution with shape
+// parameters alpha and beta. Both must be > 0; the result is in [0,1].
+func Beta(alpha, beta float64) (float64, error) {
+	return Default().Beta(alpha, beta)
+}
+
+// Beta returns a random value from a beta distribution with shape
+// parameters alpha and beta using the generator's entropy source.
+func (g *Generator) Beta(alpha, beta float64) (float64, error) {
+	if !isFinite(alpha) || !isFinite(beta) {
+		return 0, errNonFiniteParameter
+	}
+	if alpha <= 0 || beta <= 0 {
+		return 0, core.ErrNonPositiveBound
+	}
+	// X/(X+Y) for X ~ Gamma(alpha), Y ~ Gamma(beta), computed from the
+	// logarithms so that small shapes do not underflow both to zero.
+	lx, err := g.logGammaStandard(alpha)
+	if err != nil {
+		return 0, err
+	}
+	ly, err := g.logGammaStandard(beta)
+	if err != nil {
+		return 0, err
+	}
+	return 1 / (1 + math.Exp(ly-lx)), nil
+}
+
+// logGammaStandard returns the logarithm of a Gamma(alpha, 1) variate.
+func (g *Generator) logGammaStandard(alpha float64) (float64, error) {
+	if alpha >= 1 {
+		x, err := g.gammaStandard(alpha)
+		if err != nil {
+			return 0, err
+		}
+		return math.Log(x), nil
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	if u == 0 {
+		u = math.SmallestNonzeroFloat64
+	}
+	x, err := g.gammaStandard(alpha + 1)
+	if err != nil {
+		return 0, err
+	}
+	return math.Log(x) + math.Log(u)/alpha, nil
+}
@@ -0,0 +1,107 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Dirichlet returns a random probability vector from a Dirichlet
+// distribution with concentration parameters alphas. alphas must be
+// non-empty and every alpha > 0; the result has the same length and sums
+// to 1.
+func Dirichlet(alphas []float64) ([]float64, error) {
+	return Default().Dirichlet(alphas)
+}
+
+// Multinomial returns how many of n independent trials land in each
+// category, where category i has probability proportional to probs[i].
+// probs follows the rules of Categorical; the counts sum to n.
+func Multinomial(n int, probs []float64) ([]int, error) {
+	return Default().Multinomial(n, probs)
+}
+
+// Dirichlet returns a Dirichlet(alphas) probability vector using the
+// generator's entropy source.
+func (g *Generator) Dirichlet(alphas []float64) ([]float64, error) {
+	if len(alphas) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	for _, a := range alphas {
+		if !isFinite(a) {
+			return nil, errNonFiniteParameter
+		}
+		if a <= 0 {
+			return nil, core.ErrNonPositiveBound
+		}
+	}
+	// Normalized Gamma(alpha_i) variates, computed from their logarithms
+	// and shifted by the maximum so that small alphas cannot underflow
+	// every component to zero.
+	out := make([]float64, len(alphas))
+	peak := math.Inf(-1)
+	for i, a := range alphas {
+		l, err := g.logGammaStandard(a)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = l
+		peak = math.Max(peak, l)
+	}
+	var sum float64
+	for i, l := range out {
+		out[i] = math.Exp(l - peak)
+		sum += out[i]
+	}
+	for i := range out {
+		out[i] /= sum
+	}
+	return out, nil
+}
+
+// Multinomial returns per-category counts for n trials using the
+// generator's entropy source. It costs O(len(probs)) binomial draws
+// regardless of n.
+func (g *Generator) Multinomial(n int, probs []float64) ([]int, error) {
+	if n < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	if len(probs) == 0 {
+		return nil, core.ErrInvalidWeights
+	}
+	var sum float64
+	last := -1
+	for i, w := range probs {
+		if !isFinite(w) || w < 0 {
+			return nil, core.ErrInvalidWeights
+		}
+		if w > 0 {
+			last = i
+		}
+		sum += w
+	}
+	if last < 0 || !isFinite(sum) {
+		return nil, core.ErrInvalidWeights
+	}
+
+	// Conditional method: category i receives Binomial(remaining, p_i)
+	// where p_i is its share of the probability not yet assigned.
+	counts := make([]int, len(probs))
+	remaining := n
+	mass := sum
+	for i := 0; i < last && remaining > 0; i++ {
+		w := probs[i]
+		if w == 0 {
+			continue
+		}
+		x, err := g.binomial(remaining, math.Min(w/mass, 1))
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = x
+		remaining -= x
+		mass -= w
+	}
+	counts[last] += remaining
+	return counts, nil
+}
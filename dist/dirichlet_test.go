@@ -0,0 +1,101 @@
+package dist
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestDirichletMeans(t *testing.T) {
+	gen := New(nil)
+	alphas := []float64{1, 2, 7}
+	const n = 20000
+	sums := make([]float64, len(alphas))
+	for i := 0; i < n; i++ {
+		x, err := gen.Dirichlet(alphas)
+		if err != nil {
+			t.Fatalf("Dirichlet error: %v", err)
+		}
+		var total float64
+		for j, v := range x {
+			if v < 0 || v > 1 {
+				t.Fatalf("component %v out of [0,1]", v)
+			}
+			total += v
+			sums[j] += v
+		}
+		if math.Abs(total-1) > 1e-12 {
+			t.Fatalf("components sum to %v", total)
+		}
+	}
+	for j, a := range alphas {
+		if mean, want := sums[j]/n, a/10; math.Abs(mean-want) > 0.01 {
+			t.Fatalf("component %d mean=%.4f want %.4f", j, mean, want)
+		}
+	}
+}
+
+func TestDirichletSmallAlphas(t *testing.T) {
+	gen := New(nil)
+	for i := 0; i < 1000; i++ {
+		x, err := gen.Dirichlet([]float64{0.001, 0.001, 0.001})
+		if err != nil {
+			t.Fatalf("Dirichlet error: %v", err)
+		}
+		for _, v := range x {
+			if math.IsNaN(v) {
+				t.Fatalf("Dirichlet returned NaN: %v", x)
+			}
+		}
+	}
+}
+
+func TestMultinomialCounts(t *testing.T) {
+	gen := New(nil)
+	probs := []float64{0.1, 0, 0.3, 0.6}
+	const trials, n = 2000, 500
+	sums := make([]float64, len(probs))
+	for i := 0; i < trials; i++ {
+		counts, err := gen.Multinomial(n, probs)
+		if err != nil {
+			t.Fatalf("Multinomial error: %v", err)
+		}
+		total := 0
+		for j, c := range counts {
+			total += c
+			sums[j] += float64(c)
+		}
+		if total != n || counts[1] != 0 {
+			t.Fatalf("Multinomial counts=%v", counts)
+		}
+	}
+	for j, p := range probs {
+		if mean, want := sums[j]/trials, n*p; math.Abs(mean-want) > 0.02*want+0.5 {
+			t.Fatalf("category %d mean=%.2f want %.2f", j, mean, want)
+		}
+	}
+}
+
+func TestDirichletMultinomialErrors(t *testing.T) {
+	gen := New(nil)
+	cases := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{"dirichlet empty", func() error { _, err := gen.Dirichlet(nil); return err }, core.ErrEmptyItems},
+		{"dirichlet alpha", func() error { _, err := gen.Dirichlet([]float64{1, 0}); return err }, core.ErrNonPositiveBound},
+		{"dirichlet nan", func() error { _, err := gen.Dirichlet([]float64{math.NaN()}); return err }, errNonFiniteParameter},
+		{"multinomial n", func() error { _, err := gen.Multinomial(-1, []float64{1}); return err }, core.ErrNegativeLength},
+		{"multinomial empty", func() error { _, err := gen.Multinomial(1, nil); return err }, core.ErrInvalidWeights},
+		{"multinomial zero", func() error { _, err := gen.Multinomial(1, []float64{0, 0}); return err }, core.ErrInvalidWeights},
+		{"multinomial negative", func() error { _, err := gen.Multinomial(1, []float64{1, -1}); return err }, core.ErrInvalidWeights},
+	}
+	for _, tc := range cases {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Binomial returns the number of successes in n independent trials that
+// each succeed with probability p. n must be >= 0 and p in [0,1].
+func Binomial(n int, p float64) (int, error) {
+	return Default().Binomial(n, p)
+}
+
+// Geometric returns the number of trials up to and including the first
+// success, where each trial succeeds with probability p. p must be in
+// (0,1]; the result is >= 1.
+func Geometric(p float64) (int, error) {
+	return Default().Geometric(p)
+}
+
+// NegativeBinomial returns the number of failures before the r-th success
+// in trials that succeed with probability p. r must be > 0 (non-integer r
+// is allowed) and p in (0,1].
+func NegativeBinomial(r, p float64) (int, error) {
+	return Default().NegativeBinomial(r, p)
+}
+
+// Binomial returns a Binomial(n, p) variate using the generator's entropy
+// source.
+func (g *Generator) Binomial(n int, p float64) (int, error) {
+	if n < 0 {
+		return 0, core.ErrNegativeLength
+	}
+	if !isFinite(p) || p < 0 || p > 1 {
+		return 0, core.ErrInvalidProbability
+	}
+	return g.binomial(n, p)
+}
+
+// Geometric returns a Geometric(p) variate on {1, 2, ...} using the
+// generator's entropy source. Results too large for an int saturate at
+// math.MaxInt.
+func (g *Generator) Geometric(p float64) (int, error) {
+	if !isFinite(p) || p <= 0 || p > 1 {
+		return 0, core.ErrInvalidProbability
+	}
+	if p == 1 {
+		return 1, nil
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	k := math.Floor(math.Log1p(-u) / math.Log1p(-p))
+	if k >= math.MaxInt-1 {
+		return math.MaxInt, nil
+	}
+	return int(k) + 1, nil
+}
+
+// NegativeBinomial returns a NegativeBinomial(r, p) variate using the
+// generator's entropy source, drawn as a Poisson whose rate is
+// Gamma(r, p/(1-p)) distributed.
+func (g *Generator) NegativeBinomial(r, p float64) (int, error) {
+	if !isFinite(r) {
+		return 0, errNonFiniteParameter
+	}
+	if r <= 0 {
+		return 0, core.ErrNonPositiveBound
+	}
+	if !isFinite(p) || p <= 0 || p > 1 {
+		return 0, core.ErrInvalidProbability
+	}
+	if p == 1 {
+		return 0, nil
+	}
+	lambda, err := g.Gamma(r, p/(1-p))
+	if err != nil {
+		return 0, err
+	}
+	if lambda <= 0 {
+		return 0, nil
+	}
+	return g.Poisson(lambda)
+}
+
+// binomial draws from Binomial(n, p) exactly: by inversion when the mean
+// is small and by Hörmann's BTRS transformed rejection otherwise.
+func (g *Generator) binomial(n int, p float64) (int, error) {
+	if n == 0 || p <= 0 {
+		return 0, nil
+	}
+	if p >= 1 {
+		return n, nil
+	}
+	if p > 0.5 {
+		x, err := g.binomial(n, 1-p)
+		return n - x, err
+	}
+	if float64(n)*p < 10 {
+		return g.binomialInversion(n, p)
+	}
+	return g.binomialBTRS(n, p)
+}
+
+func (g *Generator) binomialInversion(n int, p float64) (int, error) {
+	q := 1 - p
+	s := p / q
+	a := float64(n+1) * s
+	r0 := math.Pow(q, float64(n))
+	for {
+		u, err := g.rng.Float64()
+		if err != nil {
+			return 0, err
+		}
+		r := r0
+		x := 0
+		for u > r && x < n {
+			u -= r
+			x++
+			r *= a/float64(x) - s
+		}
+		// Rounding can leave u above the remaining tail; retry rather
+		// than bias the result towards n.
+		if u <= r {
+			return x, nil
+		}
+	}
+}
+
+func (g *Generator) binomialBTRS(n int, p float64) (int, error) {
+	fn := float64(n)
+	q := 1 - p
+	spq := math.Sqrt(fn * p * q)
+	b := 1.15 + 2.53*spq
+	a := -0.0873 + 0.0248*b + 0.01*p
+	c := fn*p + 0.5
+	vr := 0.92 - 4.2/b
+	alpha := (2.83 + 5.1/b) * spq
+	lpq := math.Log(p / q)
+	m := math.Floor((fn + 1) * p)
+	lgm, _ := math.Lgamma(m + 1)
+	lgnm, _ := math.Lgamma(fn - m + 1)
+	h := lgm + lgnm
+	for {
+		u, err := g.rng.Float64()
+		if err != nil {
+			return 0, err
+		}
+		v, err := g.rng.Float64()
+		if err != nil {
+			return 0, err
+		}
+		u -= 0.5
+		us := 0.5 - math.Abs(u)
+		if us == 0 {
+			continue
+		}
+		k := math.Floor((2*a/us+b)*u + c)
+		if k < 0 || k > fn {
+			continue
+		}
+		if us >= 0.07 && v <= vr {
+			return int(k), nil
+		}
+		if v == 0 {
+			continue
+		}
+		v = math.Log(v * alpha / (a/(us*us) + b))
+		lgk, _ := math.Lgamma(k + 1)
+		lgnk, _ := math.Lgamma(fn - k + 1)
+		if v <= h-lgk-lgnk+(k-m)*lpq {
+			return int(k), nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package dist
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestBinomialEdges(t *testing.T) {
+	gen := New(nil)
+	for _, tc := range []struct {
+		n    int
+		p    float64
+		want int
+	}{{0, 0.5, 0}, {10, 0, 0}, {10, 1, 10}} {
+		x, err := gen.Binomial(tc.n, tc.p)
+		if err != nil || x != tc.want {
+			t.Fatalf("Binomial(%d, %g)=%d err=%v want %d", tc.n, tc.p, x, err, tc.want)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		x, err := gen.Binomial(7, 0.5)
+		if err != nil || x < 0 || x > 7 {
+			t.Fatalf("Binomial(7, 0.5)=%d err=%v", x, err)
+		}
+	}
+}
+
+func TestGeometricDeterministic(t *testing.T) {
+	// u = 0.75 with p = 0.5: floor(log(0.25)/log(0.5)) + 1 = 3.
+	gen := newGen(testutil.Float64Bytes(0.75))
+	k, err := gen.Geometric(0.5)
+	if err != nil || k != 3 {
+		t.Fatalf("Geometric=%d err=%v want 3", k, err)
+	}
+	if k, err := New(nil).Geometric(1); err != nil || k != 1 {
+		t.Fatalf("Geometric(1)=%d err=%v want 1", k, err)
+	}
+	if k, err := New(nil).Geometric(1e-300); err != nil || k < 1 {
+		t.Fatalf("Geometric(1e-300)=%d err=%v", k, err)
+	}
+}
+
+func TestBetaSmallShapes(t *testing.T) {
+	gen := New(nil)
+	var extremes int
+	for i := 0; i < 2000; i++ {
+		x, err := gen.Beta(0.01, 0.01)
+		if err != nil {
+			t.Fatalf("Beta error: %v", err)
+		}
+		if math.IsNaN(x) || x < 0 || x > 1 {
+			t.Fatalf("Beta(0.01, 0.01)=%v", x)
+		}
+		if x < 0.01 || x > 0.99 {
+			extremes++
+		}
+	}
+	// Beta(0.01, 0.01) puts almost all mass near 0 and 1.
+	if extremes < 1800 {
+		t.Fatalf("only %d of 2000 draws near the edges", extremes)
+	}
+}
+
+func TestDiscreteParamErrors(t *testing.T) {
+	gen := New(nil)
+	cases := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{"binomial n", func() error { _, err := gen.Binomial(-1, 0.5); return err }, core.ErrNegativeLength},
+		{"binomial p", func() error { _, err := gen.Binomial(5, 1.5); return err }, core.ErrInvalidProbability},
+		{"binomial nan", func() error { _, err := gen.Binomial(5, math.NaN()); return err }, core.ErrInvalidProbability},
+		{"geometric zero", func() error { _, err := gen.Geometric(0); return err }, core.ErrInvalidProbability},
+		{"negbin r", func() error { _, err := gen.NegativeBinomial(0, 0.5); return err }, core.ErrNonPositiveBound},
+		{"negbin inf", func() error { _, err := gen.NegativeBinomial(math.Inf(1), 0.5); return err }, errNonFiniteParameter},
+		{"negbin p", func() error { _, err := gen.NegativeBinomial(2, 0); return err }, core.ErrInvalidProbability},
+		{"beta shape", func() error { _, err := gen.Beta(0, 1); return err }, core.ErrNonPositiveBound},
+		{"beta nan", func() error { _, err := gen.Beta(1, math.NaN()); return err }, errNonFiniteParameter},
+	}
+	for _, tc := range cases {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+	if x, err := gen.NegativeBinomial(3, 1); err != nil || x != 0 {
+		t.Fatalf("NegativeBinomial(3, 1)=%d err=%v", x, err)
+	}
+}
+func TestBinomialVariance(t *testing.T) {
+	// Exercise both the inversion and BTRS paths, including p > 0.5.
+	for _, tc := range []struct {
+		n int
+		p float64
+	}{{20, 0.2}, {1000, 0.3}, {1000, 0.9}} {
+		const trials = 20000
+		var sum, sumSq float64
+		for i := 0; i < trials; i++ {
+			x, err := New(nil).Binomial(tc.n, tc.p)
+			if err != nil {
+				t.Fatalf("Binomial error: %v", err)
+			}
+			if x < 0 || x > tc.n {
+				t.Fatalf("Binomial(%d,%g)=%d out of range", tc.n, tc.p, x)
+			}
+			sum += float64(x)
+			sumSq += float64(x) * float64(x)
+		}
+		mean := sum / trials
+		variance := sumSq/trials - mean*mean
+		wantMean := float64(tc.n) * tc.p
+		wantVar := wantMean * (1 - tc.p)
+		if math.Abs(mean-wantMean) > 0.02*wantMean {
+			t.Fatalf("n=%d p=%g mean=%.2f want %.2f", tc.n, tc.p, mean, wantMean)
+		}
+		if math.Abs(variance-wantVar) > 0.1*wantVar {
+			t.Fatalf("n=%d p=%g var=%.2f want %.2f", tc.n, tc.p, variance, wantVar)
+		}
+	}
+}
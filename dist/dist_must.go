@@ -44,3 +44,81 @@ func MustPoisson(lambda float64) int {
 func MustGamma(alpha, beta float64) float64 {
 	return Default().MustGamma(alpha, beta)
 }
+
+// MustBeta returns a random value from a beta distribution
+// with shape parameters alpha and beta. It panics on error.
+func MustBeta(alpha, beta float64) float64 {
+	return Default().MustBeta(alpha, beta)
+}
+
+// MustBinomial returns the number of successes in n trials
+// with success probability p. It panics on error.
+func MustBinomial(n int, p float64) int {
+	return Default().MustBinomial(n, p)
+}
+
+// MustGeometric returns the number of trials up to the first success
+// with success probability p. It panics on error.
+func MustGeometric(p float64) int {
+	return Default().MustGeometric(p)
+}
+
+// MustNegativeBinomial returns the number of failures before the r-th
+// success with success probability p. It panics on error.
+func MustNegativeBinomial(r, p float64) int {
+	return Default().MustNegativeBinomial(r, p)
+}
+
+// MustLogNormal returns a random value from a log-normal distribution
+// with log-mean mu and log-stddev sigma. It panics on error.
+func MustLogNormal(mu, sigma float64) float64 {
+	return Default().MustLogNormal(mu, sigma)
+}
+
+// MustWeibull returns a random value from a Weibull distribution
+// with shape k and scale lambda. It panics on error.
+func MustWeibull(k, lambda float64) float64 {
+	return Default().MustWeibull(k, lambda)
+}
+
+// MustPareto returns a random value from a Pareto distribution
+// with scale xm and shape alpha. It panics on error.
+func MustPareto(xm, alpha float64) float64 {
+	return Default().MustPareto(xm, alpha)
+}
+
+// MustCauchy returns a random value from a Cauchy distribution
+// with location x0 and scale gamma. It panics on error.
+func MustCauchy(x0, gamma float64) float64 {
+	return Default().MustCauchy(x0, gamma)
+}
+
+// MustChiSquared returns a random value from a chi-squared distribution
+// with df degrees of freedom. It panics on error.
+func MustChiSquared(df float64) float64 {
+	return Default().MustChiSquared(df)
+}
+
+// MustStudentT returns a random value from Student's t distribution
+// with df degrees of freedom. It panics on error.
+func MustStudentT(df float64) float64 {
+	return Default().MustStudentT(df)
+}
+
+// MustFDist returns a random value from an F distribution
+// with d1 and d2 degrees of freedom. It panics on error.
+func MustFDist(d1, d2 float64) float64 {
+	return Default().MustFDist(d1, d2)
+}
+
+// MustDirichlet returns a random probability vector from a Dirichlet
+// distribution with concentration parameters alphas. It panics on error.
+func MustDirichlet(alphas []float64) []float64 {
+	return Default().MustDirichlet(alphas)
+}
+
+// MustMultinomial returns per-category counts for n trials with category
+// probabilities proportional to probs. It panics on error.
+func MustMultinomial(n int, probs []float64) []int {
+	return Default().MustMultinomial(n, probs)
+}
@@ -27,3 +27,18 @@ func ExampleGenerator_Bernoulli() {
 	fmt.Println(v)
 	// Output: true
 }
+
+func ExampleNewMultivariateNormal() {
+	// CPU and memory utilization that tend to rise together.
+	mvn, err := NewMultivariateNormal(
+		[]float64{0.5, 0.6},
+		[][]float64{{0.01, 0.008}, {0.008, 0.01}},
+	)
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	x, _ := mvn.Sample()
+	fmt.Println(len(x))
+	// Output: 2
+}
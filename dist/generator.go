@@ -12,6 +12,7 @@ var (
 	errInvalidMeanStd      = errors.New("randutil: invalid mean/stddev")
 	errNonFiniteParameter  = errors.New("randutil: parameter must be finite")
 	errInvalidUniformRange = errors.New("randutil: min must be < max")
+	errInvalidCovariance   = errors.New("randutil: covariance must be a symmetric positive semi-definite matrix matching mean")
 )
 
 // Generator builds distribution samples using a core RNG.
@@ -78,3 +78,146 @@ func (g *Generator) MustGamma(alpha, beta float64) float64 {
 	}
 	return f
 }
+
+// MustBeta returns a random value from a beta distribution
+// with shape parameters alpha and beta using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustBeta(alpha, beta float64) float64 {
+	f, err := g.Beta(alpha, beta)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustBinomial returns the number of successes in n trials with success
+// probability p using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustBinomial(n int, p float64) int {
+	i, err := g.Binomial(n, p)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// MustGeometric returns the number of trials up to the first success
+// with success probability p using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustGeometric(p float64) int {
+	i, err := g.Geometric(p)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// MustNegativeBinomial returns the number of failures before the r-th
+// success with success probability p using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustNegativeBinomial(r, p float64) int {
+	i, err := g.NegativeBinomial(r, p)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// MustLogNormal returns a random value from a log-normal distribution
+// with log-mean mu and log-stddev sigma using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustLogNormal(mu, sigma float64) float64 {
+	f, err := g.LogNormal(mu, sigma)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustWeibull returns a random value from a Weibull distribution
+// with shape k and scale lambda using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustWeibull(k, lambda float64) float64 {
+	f, err := g.Weibull(k, lambda)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustPareto returns a random value from a Pareto distribution
+// with scale xm and shape alpha using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustPareto(xm, alpha float64) float64 {
+	f, err := g.Pareto(xm, alpha)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustCauchy returns a random value from a Cauchy distribution
+// with location x0 and scale gamma using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustCauchy(x0, gamma float64) float64 {
+	f, err := g.Cauchy(x0, gamma)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustChiSquared returns a random value from a chi-squared distribution
+// with df degrees of freedom using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustChiSquared(df float64) float64 {
+	f, err := g.ChiSquared(df)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustStudentT returns a random value from Student's t distribution
+// with df degrees of freedom using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustStudentT(df float64) float64 {
+	f, err := g.StudentT(df)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustFDist returns a random value from an F distribution
+// with d1 and d2 degrees of freedom using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustFDist(d1, d2 float64) float64 {
+	f, err := g.FDist(d1, d2)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// MustDirichlet returns a random probability vector from a Dirichlet
+// distribution with concentration parameters alphas using the generator's
+// entropy source. It panics on error.
+func (g *Generator) MustDirichlet(alphas []float64) []float64 {
+	x, err := g.Dirichlet(alphas)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// MustMultinomial returns per-category counts for n trials with category
+// probabilities proportional to probs using the generator's entropy source.
+// It panics on error.
+func (g *Generator) MustMultinomial(n int, probs []float64) []int {
+	counts, err := g.Multinomial(n, probs)
+	if err != nil {
+		panic(err)
+	}
+	return counts
+}
@@ -0,0 +1,88 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// LogNormal returns exp(X) for X ~ Normal(mu, sigma). sigma must be >= 0.
+func LogNormal(mu, sigma float64) (float64, error) {
+	return Default().LogNormal(mu, sigma)
+}
+
+// Weibull returns a random value from a Weibull distribution with shape k
+// and scale lambda. Both must be > 0.
+func Weibull(k, lambda float64) (float64, error) {
+	return Default().Weibull(k, lambda)
+}
+
+// Pareto returns a random value from a Pareto (type I) distribution with
+// scale xm and shape alpha. Both must be > 0; the result is >= xm.
+func Pareto(xm, alpha float64) (float64, error) {
+	return Default().Pareto(xm, alpha)
+}
+
+// Cauchy returns a random value from a Cauchy distribution with location
+// x0 and scale gamma. gamma must be > 0.
+func Cauchy(x0, gamma float64) (float64, error) {
+	return Default().Cauchy(x0, gamma)
+}
+
+// LogNormal returns a log-normal variate using the generator's entropy
+// source.
+func (g *Generator) LogNormal(mu, sigma float64) (float64, error) {
+	x, err := g.Normal(mu, sigma)
+	if err != nil {
+		return 0, err
+	}
+	return math.Exp(x), nil
+}
+
+// Weibull returns a Weibull(k, lambda) variate by inversion using the
+// generator's entropy source.
+func (g *Generator) Weibull(k, lambda float64) (float64, error) {
+	if !isFinite(k) || !isFinite(lambda) {
+		return 0, errNonFiniteParameter
+	}
+	if k <= 0 || lambda <= 0 {
+		return 0, core.ErrNonPositiveBound
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	return lambda * math.Pow(-math.Log1p(-u), 1/k), nil
+}
+
+// Pareto returns a Pareto(xm, alpha) variate by inversion using the
+// generator's entropy source.
+func (g *Generator) Pareto(xm, alpha float64) (float64, error) {
+	if !isFinite(xm) || !isFinite(alpha) {
+		return 0, errNonFiniteParameter
+	}
+	if xm <= 0 || alpha <= 0 {
+		return 0, core.ErrNonPositiveBound
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	return xm * math.Exp(-math.Log1p(-u)/alpha), nil
+}
+
+// Cauchy returns a Cauchy(x0, gamma) variate by inversion using the
+// generator's entropy source.
+func (g *Generator) Cauchy(x0, gamma float64) (float64, error) {
+	if !isFinite(x0) || !isFinite(gamma) {
+		return 0, errNonFiniteParameter
+	}
+	if gamma <= 0 {
+		return 0, core.ErrNonPositiveBound
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	return x0 + gamma*math.Tan(math.Pi*(u-0.5)), nil
+}
@@ -0,0 +1,73 @@
+package dist
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestCauchyQuartiles(t *testing.T) {
+	// Cauchy has no mean; half its mass lies within one scale of x0.
+	gen := New(nil)
+	const n = 20000
+	inside := 0
+	for i := 0; i < n; i++ {
+		x, err := gen.Cauchy(3, 2)
+		if err != nil {
+			t.Fatalf("Cauchy error: %v", err)
+		}
+		if x >= 1 && x <= 5 {
+			inside++
+		}
+	}
+	if frac := float64(inside) / n; math.Abs(frac-0.5) > 0.02 {
+		t.Fatalf("fraction within x0±gamma = %.3f want 0.5", frac)
+	}
+}
+
+func TestHeavyTailDeterministic(t *testing.T) {
+	// With u = 0.75: Weibull(1, 2) = 2*ln(4), Pareto(3, 2) = 3*sqrt(4),
+	// Cauchy(0, 1) = tan(pi/4).
+	x, err := newGen(testutil.Float64Bytes(0.75)).Weibull(1, 2)
+	if err != nil || math.Abs(x-2*math.Log(4)) > 1e-12 {
+		t.Fatalf("Weibull=%v err=%v", x, err)
+	}
+	x, err = newGen(testutil.Float64Bytes(0.75)).Pareto(3, 2)
+	if err != nil || math.Abs(x-6) > 1e-12 {
+		t.Fatalf("Pareto=%v err=%v", x, err)
+	}
+	x, err = newGen(testutil.Float64Bytes(0.75)).Cauchy(0, 1)
+	if err != nil || math.Abs(x-1) > 1e-12 {
+		t.Fatalf("Cauchy=%v err=%v", x, err)
+	}
+	x, err = New(nil).LogNormal(1, 0)
+	if err != nil || x != math.E {
+		t.Fatalf("LogNormal(1, 0)=%v err=%v", x, err)
+	}
+}
+
+func TestHeavyTailParamErrors(t *testing.T) {
+	gen := New(nil)
+	cases := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{"lognormal sigma", func() error { _, err := gen.LogNormal(0, -1); return err }, core.ErrNegativeStdDev},
+		{"lognormal nan", func() error { _, err := gen.LogNormal(math.NaN(), 1); return err }, errInvalidMeanStd},
+		{"weibull k", func() error { _, err := gen.Weibull(0, 1); return err }, core.ErrNonPositiveBound},
+		{"weibull inf", func() error { _, err := gen.Weibull(1, math.Inf(1)); return err }, errNonFiniteParameter},
+		{"pareto xm", func() error { _, err := gen.Pareto(-1, 1); return err }, core.ErrNonPositiveBound},
+		{"pareto nan", func() error { _, err := gen.Pareto(1, math.NaN()); return err }, errNonFiniteParameter},
+		{"cauchy gamma", func() error { _, err := gen.Cauchy(0, 0); return err }, core.ErrNonPositiveBound},
+		{"cauchy inf", func() error { _, err := gen.Cauchy(math.Inf(-1), 1); return err }, errNonFiniteParameter},
+	}
+	for _, tc := range cases {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+}
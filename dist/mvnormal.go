@@ -0,0 +1,121 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// MultivariateNormal is a precomputed sampler for a multivariate normal
+// distribution N(mean, cov). The covariance is factored once as
+// cov = L*L^T, so each Sample costs len(mean) standard normals plus one
+// triangular matrix-vector product.
+type MultivariateNormal struct {
+	gen  *Generator
+	mean []float64
+	chol [][]float64 // lower triangular
+}
+
+// NewMultivariateNormal builds a multivariate normal sampler using the
+// default generator. cov must be a len(mean) x len(mean) symmetric positive
+// semi-definite matrix.
+func NewMultivariateNormal(mean []float64, cov [][]float64) (*MultivariateNormal, error) {
+	return Default().MultivariateNormal(mean, cov)
+}
+
+// MultivariateNormal builds a multivariate normal sampler using the
+// generator's entropy source. mean and cov are copied.
+func (g *Generator) MultivariateNormal(mean []float64, cov [][]float64) (*MultivariateNormal, error) {
+	if len(mean) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	for _, m := range mean {
+		if !isFinite(m) {
+			return nil, errNonFiniteParameter
+		}
+	}
+	chol, err := cholesky(cov, len(mean))
+	if err != nil {
+		return nil, err
+	}
+	return &MultivariateNormal{gen: g, mean: append([]float64(nil), mean...), chol: chol}, nil
+}
+
+// Dim returns the dimension of the distribution.
+func (m *MultivariateNormal) Dim() int {
+	return len(m.mean)
+}
+
+// Sample draws one vector from N(mean, cov).
+func (m *MultivariateNormal) Sample() ([]float64, error) {
+	n := len(m.mean)
+	z := make([]float64, n)
+	for i := range z {
+		v, err := m.gen.standardNormal()
+		if err != nil {
+			return nil, err
+		}
+		z[i] = v
+	}
+	out := make([]float64, n)
+	for i, row := range m.chol {
+		acc := m.mean[i]
+		for j := 0; j <= i; j++ {
+			acc += row[j] * z[j]
+		}
+		out[i] = acc
+	}
+	return out, nil
+}
+
+// cholesky returns the lower-triangular L with cov = L*L^T. Pivots that
+// are zero up to rounding are accepted, so degenerate (perfectly
+// correlated) covariances are supported.
+func cholesky(cov [][]float64, n int) ([][]float64, error) {
+	if len(cov) != n {
+		return nil, errInvalidCovariance
+	}
+	for _, row := range cov {
+		if len(row) != n {
+			return nil, errInvalidCovariance
+		}
+	}
+	var scale float64
+	for i, row := range cov {
+		for j, v := range row {
+			if !isFinite(v) {
+				return nil, errInvalidCovariance
+			}
+			if math.Abs(v-cov[j][i]) > 1e-9*math.Max(math.Abs(v), 1) {
+				return nil, errInvalidCovariance
+			}
+		}
+		scale = math.Max(scale, math.Abs(row[i]))
+	}
+	tol := 1e-12 * math.Max(scale, 1)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, i+1)
+		for j := 0; j <= i; j++ {
+			sum := cov[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum < -tol {
+					return nil, errInvalidCovariance
+				}
+				l[i][i] = math.Sqrt(math.Max(sum, 0))
+				continue
+			}
+			if l[j][j] == 0 {
+				if math.Abs(sum) > tol {
+					return nil, errInvalidCovariance
+				}
+				continue
+			}
+			l[i][j] = sum / l[j][j]
+		}
+	}
+	return l, nil
+}
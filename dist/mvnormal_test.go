@@ -0,0 +1,119 @@
+package dist
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestCholesky(t *testing.T) {
+	l, err := cholesky([][]float64{{4, 2}, {2, 3}}, 2)
+	if err != nil {
+		t.Fatalf("cholesky error: %v", err)
+	}
+	want := [][]float64{{2}, {1, math.Sqrt2}}
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(l[i][j]-want[i][j]) > 1e-12 {
+				t.Fatalf("L=%v want %v", l, want)
+			}
+		}
+	}
+}
+
+func TestMultivariateNormalMoments(t *testing.T) {
+	mean := []float64{1, -2, 0.5}
+	cov := [][]float64{
+		{2.0, 0.6, -0.4},
+		{0.6, 1.0, 0.3},
+		{-0.4, 0.3, 0.5},
+	}
+	mvn, err := New(nil).MultivariateNormal(mean, cov)
+	if err != nil {
+		t.Fatalf("MultivariateNormal error: %v", err)
+	}
+	if mvn.Dim() != 3 {
+		t.Fatalf("Dim=%d want 3", mvn.Dim())
+	}
+	const n = 40000
+	sum := make([]float64, 3)
+	prod := make([][]float64, 3)
+	for i := range prod {
+		prod[i] = make([]float64, 3)
+	}
+	for k := 0; k < n; k++ {
+		x, err := mvn.Sample()
+		if err != nil {
+			t.Fatalf("Sample error: %v", err)
+		}
+		for i := range x {
+			sum[i] += x[i]
+			for j := range x {
+				prod[i][j] += (x[i] - mean[i]) * (x[j] - mean[j])
+			}
+		}
+	}
+	for i := range mean {
+		if got := sum[i] / n; math.Abs(got-mean[i]) > 6*math.Sqrt(cov[i][i]/n) {
+			t.Fatalf("mean[%d]=%.4f want %.4f", i, got, mean[i])
+		}
+		for j := range mean {
+			if got := prod[i][j] / n; math.Abs(got-cov[i][j]) > 0.05 {
+				t.Fatalf("cov[%d][%d]=%.4f want %.4f", i, j, got, cov[i][j])
+			}
+		}
+	}
+}
+
+func TestMultivariateNormalDegenerate(t *testing.T) {
+	mvn, err := NewMultivariateNormal([]float64{0, 3}, [][]float64{{1, 1}, {1, 1}})
+	if err != nil {
+		t.Fatalf("NewMultivariateNormal error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		x, err := mvn.Sample()
+		if err != nil {
+			t.Fatalf("Sample error: %v", err)
+		}
+		if math.Abs(x[1]-x[0]-3) > 1e-12 {
+			t.Fatalf("perfectly correlated sample %v", x)
+		}
+	}
+}
+
+func TestMultivariateNormalErrors(t *testing.T) {
+	gen := New(nil)
+	cases := []struct {
+		name string
+		mean []float64
+		cov  [][]float64
+		want error
+	}{
+		{"empty", nil, nil, core.ErrEmptyItems},
+		{"nan mean", []float64{math.NaN()}, [][]float64{{1}}, errNonFiniteParameter},
+		{"rows", []float64{0, 0}, [][]float64{{1, 0}}, errInvalidCovariance},
+		{"cols", []float64{0, 0}, [][]float64{{1}, {0, 1}}, errInvalidCovariance},
+		{"ragged", []float64{0, 0, 0}, [][]float64{{1, 0, 0}, {0, 1, 0}, {0}}, errInvalidCovariance},
+		{"asymmetric", []float64{0, 0}, [][]float64{{1, 0.5}, {0.2, 1}}, errInvalidCovariance},
+		{"indefinite", []float64{0, 0}, [][]float64{{1, 2}, {2, 1}}, errInvalidCovariance},
+		{"negative variance", []float64{0}, [][]float64{{-1}}, errInvalidCovariance},
+		{"inf", []float64{0}, [][]float64{{math.Inf(1)}}, errInvalidCovariance},
+	}
+	for _, tc := range cases {
+		if _, err := gen.MultivariateNormal(tc.mean, tc.cov); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+	failing := New(core.New(testutil.ErrReader{Err: io.ErrUnexpectedEOF}))
+	mvn, err := failing.MultivariateNormal([]float64{0}, [][]float64{{1}})
+	if err != nil {
+		t.Fatalf("MultivariateNormal error: %v", err)
+	}
+	if _, err := mvn.Sample(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("err=%v want %v", err, io.ErrUnexpectedEOF)
+	}
+}
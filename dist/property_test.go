@@ -41,6 +41,98 @@ func TestDistributionStats(t *testing.T) {
 				return g.Gamma(2.5, 1.3)
 			},
 		},
+		{
+			name:         "beta",
+			expectedMean: 2.0 / 7.0,
+			expectedVar:  10.0 / (49.0 * 8.0),
+			sample: func(g *Generator) (float64, error) {
+				return g.Beta(2, 5)
+			},
+		},
+		{
+			name:         "binomial",
+			expectedMean: 12,
+			expectedVar:  8.4,
+			sample: func(g *Generator) (float64, error) {
+				v, err := g.Binomial(40, 0.3)
+				return float64(v), err
+			},
+		},
+		{
+			name:         "binomial-btrs",
+			expectedMean: 900,
+			expectedVar:  90,
+			sample: func(g *Generator) (float64, error) {
+				v, err := g.Binomial(1000, 0.9)
+				return float64(v), err
+			},
+		},
+		{
+			name:         "geometric",
+			expectedMean: 4,
+			expectedVar:  12,
+			sample: func(g *Generator) (float64, error) {
+				v, err := g.Geometric(0.25)
+				return float64(v), err
+			},
+		},
+		{
+			name:         "negative-binomial",
+			expectedMean: 3.5 * 0.6 / 0.4,
+			expectedVar:  3.5 * 0.6 / (0.4 * 0.4),
+			sample: func(g *Generator) (float64, error) {
+				v, err := g.NegativeBinomial(3.5, 0.4)
+				return float64(v), err
+			},
+		},
+		{
+			name:         "lognormal",
+			expectedMean: math.Exp(0.125),
+			expectedVar:  (math.Exp(0.25) - 1) * math.Exp(0.25),
+			sample: func(g *Generator) (float64, error) {
+				return g.LogNormal(0, 0.5)
+			},
+		},
+		{
+			name:         "weibull",
+			expectedMean: math.Gamma(1.5),
+			expectedVar:  1 - math.Pi/4,
+			sample: func(g *Generator) (float64, error) {
+				return g.Weibull(2, 1)
+			},
+		},
+		{
+			name:         "pareto",
+			expectedMean: 10.0 / 9.0,
+			expectedVar:  10.0 / (81.0 * 8.0),
+			sample: func(g *Generator) (float64, error) {
+				return g.Pareto(1, 10)
+			},
+		},
+		{
+			name:         "chi-squared",
+			expectedMean: 4,
+			expectedVar:  8,
+			sample: func(g *Generator) (float64, error) {
+				return g.ChiSquared(4)
+			},
+		},
+		{
+			name:         "student-t",
+			expectedMean: 0,
+			expectedVar:  10.0 / 8.0,
+			sample: func(g *Generator) (float64, error) {
+				return g.StudentT(10)
+			},
+		},
+		{
+			name:         "f",
+			expectedMean: 30.0 / 28.0,
+			expectedVar:  2 * 900.0 * 33.0 / (5 * 784.0 * 26.0),
+			sample: func(g *Generator) (float64, error) {
+				return g.FDist(5, 30)
+			},
+		},
 		{
 			name:         "uniform",
 			expectedMean: 1.0,
@@ -0,0 +1,53 @@
+package dist
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestSamplingDistributionsSupport(t *testing.T) {
+	gen := New(nil)
+	for i := 0; i < 2000; i++ {
+		c, err := gen.ChiSquared(0.5)
+		if err != nil || c < 0 || math.IsNaN(c) {
+			t.Fatalf("ChiSquared(0.5)=%v err=%v", c, err)
+		}
+		f, err := gen.FDist(1, 1)
+		if err != nil || f < 0 || math.IsNaN(f) {
+			t.Fatalf("FDist(1, 1)=%v err=%v", f, err)
+		}
+		x, err := gen.StudentT(1)
+		if err != nil || math.IsNaN(x) {
+			t.Fatalf("StudentT(1)=%v err=%v", x, err)
+		}
+	}
+}
+
+func TestSamplingParamErrors(t *testing.T) {
+	gen := New(nil)
+	cases := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{"chi-squared df", func() error { _, err := gen.ChiSquared(0); return err }, core.ErrNonPositiveBound},
+		{"student-t df", func() error { _, err := gen.StudentT(-2); return err }, core.ErrNonPositiveBound},
+		{"student-t nan", func() error { _, err := gen.StudentT(math.NaN()); return err }, errNonFiniteParameter},
+		{"f d1", func() error { _, err := gen.FDist(0, 3); return err }, core.ErrNonPositiveBound},
+		{"f d2", func() error { _, err := gen.FDist(3, math.Inf(1)); return err }, errNonFiniteParameter},
+	}
+	for _, tc := range cases {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+	failing := New(core.New(testutil.ErrReader{Err: io.ErrUnexpectedEOF}))
+	if _, err := failing.FDist(2, 3); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("err=%v want %v", err, io.ErrUnexpectedEOF)
+	}
+}
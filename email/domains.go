@@ -0,0 +1,43 @@
+package email
+
+import "strings"
+
+// safeDomains are reserved by RFC 2606 and RFC 6761 and never resolve to
+// real mail servers.
+var safeDomains = []string{
+	"example.com",
+	"example.net",
+	"example.org",
+	"*.example",
+	"*.test",
+}
+
+// SafeDomains returns a copy of the default domain pool used by Email:
+// the RFC 2606 reserved names example.com, example.net and example.org,
+// plus random labels under the reserved .example and .test TLDs.
+//
+// Addresses can still use real domains when the caller asks for them:
+// Options.DomainPart, Options.DomainPool, Options.TLD (a random label under
+// that TLD), Options.RandomDomain, and the Simple functions, which keep
+// their legacy random ".com" domains.
+func SafeDomains() []string {
+	return append([]string(nil), safeDomains...)
+}
+
+// poolDomain draws a domain from pool, expanding a leading "*." into a
+// random five-character label.
+func (g *Generator) poolDomain(pool []string) (string, error) {
+	idx, err := g.rng.Uint64n(uint64(len(pool)))
+	if err != nil {
+		return "", err
+	}
+	domain := pool[idx]
+	if suffix, ok := strings.CutPrefix(domain, "*."); ok {
+		label, err := g.strings.String(5)
+		if err != nil {
+			return "", err
+		}
+		return label + "." + suffix, nil
+	}
+	return domain, nil
+}
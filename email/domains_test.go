@@ -0,0 +1,100 @@
+package email
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEmailSafeDomain(t *testing.T) {
+	for i := 0; i < 300; i++ {
+		e, err := WithSafeDomain()
+		if err != nil {
+			t.Fatalf("WithSafeDomain error: %v", err)
+		}
+		checkSafeHost(t, e)
+		for _, o := range []Options{{}, {Style: StyleRealistic}, {PlusTag: "x"}} {
+			e, err := Email(o)
+			if err != nil {
+				t.Fatalf("Email(%+v) error: %v", o, err)
+			}
+			checkSafeHost(t, e)
+		}
+	}
+}
+
+func TestEmailRandomDomainOptOut(t *testing.T) {
+	e, err := Email(Options{RandomDomain: true})
+	if err != nil {
+		t.Fatalf("Email error: %v", err)
+	}
+	_, host, _ := strings.Cut(e, "@")
+	if len(host) != len("xxxxx.com") || !strings.HasSuffix(host, ".com") {
+		t.Fatalf("RandomDomain host = %q", host)
+	}
+}
+
+func TestEmailTotalLengthSafeDomain(t *testing.T) {
+	for n := 8; n <= 40; n++ {
+		for i := 0; i < 20; i++ {
+			e, err := Email(Options{TotalLength: n})
+			if err != nil {
+				t.Fatalf("Email(TotalLength: %d) error: %v", n, err)
+			}
+			if len(e) != n {
+				t.Fatalf("Email(TotalLength: %d) = %q has length %d", n, e, len(e))
+			}
+			checkSafeHost(t, e)
+		}
+	}
+	if _, err := Email(Options{TotalLength: 7}); !errors.Is(err, ErrTotalLengthTooSmall) {
+		t.Fatalf("TotalLength 7 err=%v want ErrTotalLengthTooSmall", err)
+	}
+	e, err := Email(Options{TotalLength: 7, RandomDomain: true})
+	if err != nil || len(e) != 7 || !strings.HasSuffix(e, ".com") {
+		t.Fatalf("RandomDomain TotalLength 7 = %q err: %v", e, err)
+	}
+}
+
+func checkSafeHost(t *testing.T, e string) {
+	t.Helper()
+	_, host, _ := strings.Cut(e, "@")
+	switch {
+	case host == "example.com", host == "example.net", host == "example.org":
+	case strings.HasSuffix(host, ".example"), strings.HasSuffix(host, ".test"):
+		if strings.HasPrefix(host, "*") {
+			t.Fatalf("wildcard not expanded: %s", e)
+		}
+	default:
+		t.Fatalf("unsafe domain %q", host)
+	}
+}
+
+func TestEmailDomainPool(t *testing.T) {
+	pool := []string{"corp.example", "*.internal.test"}
+	for i := 0; i < 100; i++ {
+		e, err := Email(Options{DomainPool: pool, TLD: "org"})
+		if err != nil {
+			t.Fatalf("Email error: %v", err)
+		}
+		_, host, _ := strings.Cut(e, "@")
+		if host != "corp.example" && !strings.HasSuffix(host, ".internal.test") {
+			t.Fatalf("host %q not from pool", host)
+		}
+	}
+	e, err := Email(Options{DomainPool: pool, DomainPart: "mine"})
+	if err != nil || !strings.HasSuffix(e, "@mine.com") {
+		t.Fatalf("DomainPart should win over pool: %q err=%v", e, err)
+	}
+	if _, err := Email(Options{DomainPool: []string{"bad domain"}}); !errors.Is(err, ErrInvalidAddress) {
+		t.Fatalf("err=%v want ErrInvalidAddress", err)
+	}
+}
+
+func TestSafeDomainsReturnsCopy(t *testing.T) {
+	d := SafeDomains()
+	d[0] = "evil.com"
+	if SafeDomains()[0] != "example.com" {
+		t.Fatal("SafeDomains exposed internal slice")
+	}
+}
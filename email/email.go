@@ -79,3 +79,14 @@ func WithRandomTLD() (string, error) {
 func WithoutTLD() (string, error) {
 	return Email(Options{TLD: "none"})
 }
+
+// WithSafeDomain returns a random email whose domain is drawn from
+// SafeDomains, so it can never reach a real inbox. It is the same as
+// Email(Options{}) and names the guarantee at the call site.
+//
+// Returns:
+//   - string: A random email address on a reserved domain.
+//   - error: An error if generation fails.
+func WithSafeDomain() (string, error) {
+	return Email(Options{})
+}
@@ -108,3 +108,16 @@ func MustWithoutTLD() string {
 	}
 	return result
 }
+
+// MustWithSafeDomain returns a random email whose domain is drawn from
+// SafeDomains. It panics if an error occurs.
+//
+// Returns:
+//   - string: A random email address on a reserved domain.
+func MustWithSafeDomain() string {
+	result, err := WithSafeDomain()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
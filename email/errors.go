@@ -5,4 +5,5 @@ import "errors"
 // Package-level errors for email validation.
 var (
 	ErrTotalLengthTooSmall = errors.New("randutil: total length must be >= 7")
+	ErrInvalidAddress      = errors.New("randutil: email address is not RFC 5322 valid")
 )
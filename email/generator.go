@@ -2,6 +2,7 @@ package email
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aatuh/randutil/v2/core"
 	"github.com/aatuh/randutil/v2/randstring"
@@ -45,23 +46,57 @@ type Options struct {
 	// string will be generated. If set, this exact value will be used.
 	LocalPart string
 
-	// DomainPart specifies the domain part of the email. If empty, a random
-	// string will be generated. If set, this exact value will be used.
+	// DomainPart specifies the domain part of the email. If empty, the
+	// domain is drawn from DomainPool or SafeDomains, or is a random string
+	// when TLD or RandomDomain is set. If set, this exact value will be used.
 	DomainPart string
 
-	// TLD specifies the top-level domain. If empty, ".com" will be used.
-	// If set to "random", a random TLD from commonTLDs will be used.
-	// If set to any other value, that exact TLD will be used.
-	// If set to "none", no TLD will be added.
+	// TLD specifies the top-level domain for DomainPart or a random domain;
+	// setting it requests a random domain when DomainPart is empty. If
+	// empty, ".com" will be used. If set to "random", a random TLD from
+	// commonTLDs will be used. If set to any other value, that exact TLD
+	// will be used. If set to "none", no TLD will be added.
 	TLD string
 
 	// TotalLength specifies the exact total length of the email address.
 	// If 0, the length will be calculated automatically based on the parts.
-	// This option is ignored if LocalPart, DomainPart, or TLD are specified.
+	// The domain comes from SafeDomains (total length >= 8) unless
+	// RandomDomain is set, in which case the result matches Simple. This
+	// option is ignored if LocalPart, DomainPart, TLD or a non-default
+	// Style are specified.
 	TotalLength int
+
+	// Style selects how a random local part is built when LocalPart is
+	// empty. The zero value is StyleRandom.
+	Style Style
+
+	// PlusTag, if set, is appended to the local part as "+tag"
+	// (sub-addressing), e.g. "jane+signup@example.com".
+	PlusTag string
+
+	// DomainPool, if non-empty and DomainPart is empty, supplies the full
+	// domain (including TLD) drawn uniformly at random; TLD is ignored.
+	// An entry of the form "*.suffix" gets a random label in place of "*".
+	DomainPool []string
+
+	// RandomDomain opts out of SafeDomains: when DomainPart, DomainPool
+	// and TLD are empty, the domain is a random string under ".com", also
+	// for TotalLength. Such addresses may route to real inboxes.
+	RandomDomain bool
+
+	// Invalid requests a deliberately malformed address that
+	// net/mail.ParseAddress rejects, for negative-path tests. The address
+	// is derived from the other options and then broken in one of several
+	// ways (missing "@", consecutive dots, embedded space, ...).
+	Invalid bool
 }
 
-// Email returns a random email address with the specified options.
+// Email returns a random email address with the specified options. Unless
+// DomainPart, DomainPool, TLD, TotalLength or RandomDomain says otherwise,
+// the domain is drawn from the RFC 2606 reserved SafeDomains. Unless
+// opts.Invalid is set, the result is guaranteed to be accepted by
+// net/mail.ParseAddress; caller-supplied parts that would break that
+// guarantee yield ErrInvalidAddress.
 //
 // Parameters:
 //   - opts: Options configuring the email generation behavior.
@@ -71,8 +106,12 @@ type Options struct {
 //   - error: An error if generation fails.
 func (g *Generator) Email(opts Options) (string, error) {
 	if opts.TotalLength > 0 && opts.LocalPart == "" &&
-		opts.DomainPart == "" && opts.TLD == "" {
-		return g.Simple(opts.TotalLength)
+		opts.DomainPart == "" && opts.TLD == "" && opts.Style == StyleRandom &&
+		opts.PlusTag == "" && !opts.Invalid && len(opts.DomainPool) == 0 {
+		if opts.RandomDomain {
+			return g.Simple(opts.TotalLength)
+		}
+		return g.safeOfLength(opts.TotalLength)
 	}
 
 	var tld string
@@ -98,22 +137,87 @@ func (g *Generator) Email(opts Options) (string, error) {
 	local := opts.LocalPart
 	if local == "" {
 		var err error
-		local, err = g.strings.String(5)
+		if opts.Style == StyleRealistic {
+			local, err = g.realisticLocal()
+		} else {
+			local, err = g.strings.String(5)
+		}
 		if err != nil {
 			return "", err
 		}
 	}
 
-	domain := opts.DomainPart
-	if domain == "" {
+	var host string
+	switch {
+	case opts.DomainPart != "":
+		host = opts.DomainPart + tld
+	case len(opts.DomainPool) > 0:
 		var err error
-		domain, err = g.strings.String(5)
+		host, err = g.poolDomain(opts.DomainPool)
+		if err != nil {
+			return "", err
+		}
+	case opts.TLD != "" || opts.RandomDomain:
+		domain, err := g.strings.String(5)
+		if err != nil {
+			return "", err
+		}
+		host = domain + tld
+	default:
+		var err error
+		host, err = g.poolDomain(safeDomains)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	return fmt.Sprintf("%s@%s%s", local, domain, tld), nil
+	if opts.PlusTag != "" {
+		local += "+" + opts.PlusTag
+	}
+	if opts.Invalid {
+		return g.invalidAddress(local, host)
+	}
+	addr := local + "@" + host
+	if err := validate(addr); err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// safeOfLength returns a random email of exactly totalLength chars on a
+// SafeDomains host, shortening wildcard labels and padding the local part
+// to hit the length.
+func (g *Generator) safeOfLength(totalLength int) (string, error) {
+	// Room for the host once "@" and a one-character local part are taken.
+	room := totalLength - 2
+	var fits []string
+	for _, d := range safeDomains {
+		// A wildcard entry is shortest with a one-character label, which
+		// is as long as the "*" it replaces.
+		if len(d) <= room {
+			fits = append(fits, d)
+		}
+	}
+	if len(fits) == 0 {
+		return "", ErrTotalLengthTooSmall
+	}
+	idx, err := g.rng.Uint64n(uint64(len(fits)))
+	if err != nil {
+		return "", err
+	}
+	host := fits[idx]
+	if suffix, ok := strings.CutPrefix(host, "*."); ok {
+		label, err := g.strings.String(min(5, room-len(suffix)-1))
+		if err != nil {
+			return "", err
+		}
+		host = label + "." + suffix
+	}
+	local, err := g.strings.String(totalLength - 1 - len(host))
+	if err != nil {
+		return "", err
+	}
+	return local + "@" + host, nil
 }
 
 // Simple returns a random email of exactly totalLength chars in the form
@@ -0,0 +1,92 @@
+package email
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aatuh/randutil/v2/internal/corpus"
+)
+
+// Style selects how Email builds a random local part.
+type Style int
+
+const (
+	// StyleRandom builds the local part from random lowercase letters and
+	// digits. It is the default.
+	StyleRandom Style = iota
+
+	// StyleRealistic composes the local part from embedded first and last
+	// name lists, e.g. "john.smith84" or "j.smith".
+	StyleRealistic
+)
+
+var (
+	firstNames = sync.OnceValue(func() []string { return lowerAll(corpus.Lines("en_US/first.txt")) })
+	lastNames  = sync.OnceValue(func() []string { return lowerAll(corpus.Lines("en_US/last.txt")) })
+)
+
+func lowerAll(words []string) []string {
+	out := make([]string, len(words))
+	for i, w := range words {
+		out[i] = strings.ToLower(w)
+	}
+	return out
+}
+
+// realisticPatterns is the number of local-part shapes realisticLocal picks
+// from.
+const realisticPatterns = 7
+
+// realisticLocal returns a lowercase local part built from a random first
+// and last name in one of several common shapes.
+func (g *Generator) realisticLocal() (string, error) {
+	first, err := g.pick(firstNames())
+	if err != nil {
+		return "", err
+	}
+	last, err := g.pick(lastNames())
+	if err != nil {
+		return "", err
+	}
+	shape, err := g.rng.Uint64n(realisticPatterns)
+	if err != nil {
+		return "", err
+	}
+	switch shape {
+	case 0:
+		return first + "." + last, nil
+	case 1:
+		n, err := g.rng.IntRange(10, 99)
+		if err != nil {
+			return "", err
+		}
+		return first + "." + last + strconv.Itoa(n), nil
+	case 2:
+		return first[:1] + "." + last, nil
+	case 3:
+		return first + last, nil
+	case 4:
+		return first + "_" + last, nil
+	case 5:
+		year, err := g.rng.IntRange(1960, 2005)
+		if err != nil {
+			return "", err
+		}
+		return first + strconv.Itoa(year), nil
+	default:
+		n, err := g.rng.IntRange(10, 99)
+		if err != nil {
+			return "", err
+		}
+		return first + last[:1] + strconv.Itoa(n), nil
+	}
+}
+
+func (g *Generator) pick(words []string) (string, error) {
+	idx, err := g.rng.Uint64n(uint64(len(words)))
+	if err != nil {
+		return "", err
+	}
+	return words[idx], nil
+}
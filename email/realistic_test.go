@@ -0,0 +1,69 @@
+package email
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+var realisticLocalRE = regexp.MustCompile(`^[a-z]+([._]?[a-z]+)?[0-9]*$`)
+
+func TestEmailRealisticStyle(t *testing.T) {
+	firsts := firstNames()
+	for i := 0; i < 500; i++ {
+		e, err := Email(Options{Style: StyleRealistic, TotalLength: 9})
+		if err != nil {
+			t.Fatalf("Email error: %v", err)
+		}
+		local, _, ok := strings.Cut(e, "@")
+		if !ok {
+			t.Fatalf("missing @: %s", e)
+		}
+		if !realisticLocalRE.MatchString(local) {
+			t.Fatalf("unexpected local part %q", local)
+		}
+		if !slices.ContainsFunc(firsts, func(f string) bool {
+			return strings.HasPrefix(local, f) || strings.HasPrefix(local, f[:1]+".")
+		}) {
+			t.Fatalf("local part %q does not start with a first name", local)
+		}
+	}
+}
+
+func TestEmailRealisticKeepsLocalOverride(t *testing.T) {
+	e, err := Email(Options{Style: StyleRealistic, LocalPart: "fixed"})
+	if err != nil {
+		t.Fatalf("Email error: %v", err)
+	}
+	if !strings.HasPrefix(e, "fixed@") {
+		t.Fatalf("expected local override: %s", e)
+	}
+}
+
+func TestNameListsAreCleanAndUnique(t *testing.T) {
+	for name, list := range map[string][]string{"first": firstNames(), "last": lastNames()} {
+		seen := map[string]bool{}
+		for _, w := range list {
+			if seen[w] {
+				t.Fatalf("%s names: duplicate %q", name, w)
+			}
+			seen[w] = true
+			if strings.Trim(w, "abcdefghijklmnopqrstuvwxyz") != "" {
+				t.Fatalf("%s names: %q is not lowercase ASCII", name, w)
+			}
+		}
+	}
+}
+
+func TestEmailRealisticPropagatesRNGError(t *testing.T) {
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.Email(Options{Style: StyleRealistic}); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
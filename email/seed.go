@@ -0,0 +1,35 @@
+package email
+
+import (
+	"io"
+
+	"github.com/aatuh/randutil/v2/adapters"
+)
+
+// forSeedLabel domain-separates ForSeed streams from other derivations of
+// the same seed. Changing it changes every ForSeed address.
+const forSeedLabel = "email.ForSeed v1"
+
+// ForSeed deterministically maps seed (typically a user ID) to a fake email
+// address, so repeated fixture runs produce the same addresses. The stream
+// is derived with adapters.DeriveSource; anyone who knows the seed can
+// reproduce the address, so never use it where addresses must be secret.
+// The output is stable for a given seed, opts and library version.
+//
+// Parameters:
+//   - seed: Identity to derive the address from.
+//   - opts: Options configuring the email generation behavior.
+//
+// Returns:
+//   - string: The derived email address.
+//   - error: An error if derivation or generation fails.
+func ForSeed(seed string, opts Options) (string, error) {
+	src, err := adapters.DeriveSource([]byte(seed), forSeedLabel)
+	if err != nil {
+		return "", err
+	}
+	if c, ok := src.(io.Closer); ok {
+		defer func() { _ = c.Close() }()
+	}
+	return NewWithSource(src).Email(opts)
+}
@@ -0,0 +1,37 @@
+package email
+
+import "testing"
+
+func TestForSeedIsStable(t *testing.T) {
+	opts := Options{Style: StyleRealistic}
+	a, err := ForSeed("user-42", opts)
+	if err != nil {
+		t.Fatalf("ForSeed error: %v", err)
+	}
+	b, err := ForSeed("user-42", opts)
+	if err != nil {
+		t.Fatalf("ForSeed error: %v", err)
+	}
+	if a != b {
+		t.Fatalf("ForSeed not stable: %q vs %q", a, b)
+	}
+	c, err := ForSeed("user-43", opts)
+	if err != nil {
+		t.Fatalf("ForSeed error: %v", err)
+	}
+	if a == c {
+		t.Fatalf("distinct seeds collided: %q", a)
+	}
+}
+
+func TestForSeedGolden(t *testing.T) {
+	got, err := ForSeed("user-42", Options{Style: StyleRealistic})
+	if err != nil {
+		t.Fatalf("ForSeed error: %v", err)
+	}
+	if got != forSeedGolden {
+		t.Fatalf("ForSeed=%q want %q; a change here breaks users' fixtures", got, forSeedGolden)
+	}
+}
+
+const forSeedGolden = "richardmorgan@udmrd.test"
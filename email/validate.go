@@ -0,0 +1,44 @@
+package email
+
+import (
+	"fmt"
+	"net/mail"
+)
+
+// validate reports whether addr is a bare RFC 5322 address as understood by
+// net/mail, without a display name or angle brackets.
+func validate(addr string) error {
+	parsed, err := mail.ParseAddress(addr)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if parsed.Name != "" || parsed.Address != addr {
+		return fmt.Errorf("%w: %q is not a bare address", ErrInvalidAddress, addr)
+	}
+	return nil
+}
+
+// invalidMutations break an address built from a non-empty local part and
+// domain so that net/mail.ParseAddress rejects it.
+var invalidMutations = []func(local, domain string) string{
+	func(l, d string) string { return l + d },                          // missing @
+	func(l, d string) string { return l + "@@" + d },                   // doubled @
+	func(l, d string) string { return l + "@" + d + "@" + d },          // two addresses
+	func(l, d string) string { return "." + l + "@" + d },              // leading dot
+	func(l, d string) string { return l + ".@" + d },                   // trailing dot
+	func(l, d string) string { return l[:1] + ".." + l[1:] + "@" + d }, // consecutive dots
+	func(l, d string) string { return l[:1] + " " + l[1:] + "@" + d },  // embedded space
+	func(_, d string) string { return "@" + d },                        // empty local part
+	func(l, _ string) string { return l + "@" },                        // empty domain
+	func(l, d string) string { return l + "@." + d },                   // leading dot in domain
+	func(l, d string) string { return l + "@" + d + "." },              // trailing dot in domain
+}
+
+// invalidAddress returns a randomly chosen malformed variant of local@domain.
+func (g *Generator) invalidAddress(local, domain string) (string, error) {
+	idx, err := g.rng.Uint64n(uint64(len(invalidMutations)))
+	if err != nil {
+		return "", err
+	}
+	return invalidMutations[idx](local, domain), nil
+}
@@ -0,0 +1,84 @@
+package email
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestEmailOutputParses(t *testing.T) {
+	opts := []Options{
+		{},
+		{TLD: "random"},
+		{TLD: "none"},
+		{Style: StyleRealistic},
+		{PlusTag: "signup"},
+		{Style: StyleRealistic, PlusTag: "a.b-c"},
+		{TotalLength: 12},
+	}
+	for _, o := range opts {
+		for i := 0; i < 200; i++ {
+			e, err := Email(o)
+			if err != nil {
+				t.Fatalf("Email(%+v) error: %v", o, err)
+			}
+			a, err := mail.ParseAddress(e)
+			if err != nil || a.Address != e {
+				t.Fatalf("Email(%+v)=%q does not parse: %v", o, e, err)
+			}
+		}
+	}
+}
+
+func TestEmailPlusTag(t *testing.T) {
+	e, err := Email(Options{LocalPart: "jane", DomainPart: "example", PlusTag: "news"})
+	if err != nil {
+		t.Fatalf("Email error: %v", err)
+	}
+	if e != "jane+news@example.com" {
+		t.Fatalf("Email=%q want jane+news@example.com", e)
+	}
+}
+
+func TestEmailRejectsInvalidParts(t *testing.T) {
+	for _, o := range []Options{
+		{LocalPart: "has space"},
+		{LocalPart: "a..b"},
+		{PlusTag: "bad tag"},
+		{DomainPart: "bad..domain"},
+		{LocalPart: "Bob <bob"},
+	} {
+		if _, err := Email(o); !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("Email(%+v) err=%v want ErrInvalidAddress", o, err)
+		}
+	}
+}
+
+func TestInvalidMutationsAllFail(t *testing.T) {
+	for i, mutate := range invalidMutations {
+		for _, parts := range [][2]string{{"john.smith", "example.com"}, {"x", "example"}, {"a+b", "test"}} {
+			addr := mutate(parts[0], parts[1])
+			if _, err := mail.ParseAddress(addr); err == nil {
+				t.Fatalf("mutation %d produced parseable %q", i, addr)
+			}
+		}
+	}
+}
+
+func TestEmailInvalid(t *testing.T) {
+	atCounts := map[int]bool{}
+	for i := 0; i < 500; i++ {
+		e, err := Email(Options{Invalid: true, Style: StyleRealistic})
+		if err != nil {
+			t.Fatalf("Email error: %v", err)
+		}
+		if _, err := mail.ParseAddress(e); err == nil {
+			t.Fatalf("Invalid address %q parsed", e)
+		}
+		atCounts[strings.Count(e, "@")] = true
+	}
+	if len(atCounts) < 3 {
+		t.Fatal("Invalid addresses show no variety")
+	}
+}
@@ -0,0 +1,111 @@
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PostalAddress is a fake postal address. Cities, regions and postal code
+// prefixes are consistent with each other; street names and house numbers
+// are drawn independently.
+type PostalAddress struct {
+	// Street is the street name and house number in local order.
+	Street string
+	// City is the city or town.
+	City string
+	// Region is the state, Land, région or province.
+	Region string
+	// PostalCode is a five-digit postal code with the city's prefix.
+	PostalCode string
+	// Country is the English country name.
+	Country string
+	// CountryCode is the ISO 3166-1 alpha-2 country code.
+	CountryCode string
+}
+
+// String formats a as a single line in the local convention.
+func (a PostalAddress) String() string {
+	switch a.CountryCode {
+	case "US":
+		return fmt.Sprintf("%s, %s, %s %s, %s", a.Street, a.City, a.Region, a.PostalCode, a.Country)
+	default:
+		return fmt.Sprintf("%s, %s %s, %s", a.Street, a.PostalCode, a.City, a.Country)
+	}
+}
+
+// countries maps a region code to its English name.
+var countries = map[string]string{
+	"US": "United States",
+	"DE": "Germany",
+	"FR": "France",
+	"ES": "Spain",
+}
+
+// Address returns a random postal address from the default generator.
+//
+// Returns:
+//   - PostalAddress: An address for the default generator's locale.
+//   - error: An error if the RNG fails.
+func Address() (PostalAddress, error) {
+	return Default().Address()
+}
+
+// Address returns a random postal address for g's locale.
+func (g *Generator) Address() (PostalAddress, error) {
+	street, err := g.pick(g.locale.lines("streets"))
+	if err != nil {
+		return PostalAddress{}, err
+	}
+	city, err := g.pick(g.locale.lines("cities"))
+	if err != nil {
+		return PostalAddress{}, err
+	}
+	// cities.txt lines are "city<TAB>region<TAB>postal prefix".
+	fields := strings.Split(city, "\t")
+	region := g.locale.Region()
+
+	maxNumber := 199
+	if region == "US" {
+		maxNumber = 9999
+	}
+	n, err := g.rng.IntRange(1, maxNumber)
+	if err != nil {
+		return PostalAddress{}, err
+	}
+	number := strconv.Itoa(n)
+	switch region {
+	case "US", "FR":
+		street = number + " " + street
+	case "ES":
+		street = street + ", " + number
+	default:
+		street = street + " " + number
+	}
+
+	postal, err := g.digits(5 - len(fields[2]))
+	if err != nil {
+		return PostalAddress{}, err
+	}
+	return PostalAddress{
+		Street:      street,
+		City:        fields[0],
+		Region:      fields[1],
+		PostalCode:  fields[2] + postal,
+		Country:     countries[region],
+		CountryCode: region,
+	}, nil
+}
+
+// digits returns n random decimal digits.
+func (g *Generator) digits(n int) (string, error) {
+	b := make([]byte, n)
+	for i := range b {
+		d, err := g.rng.Uint64n(10)
+		if err != nil {
+			return "", err
+		}
+		b[i] = '0' + byte(d) // #nosec G115 -- d < 10
+	}
+	return string(b), nil
+}
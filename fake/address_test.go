@@ -0,0 +1,97 @@
+package fake
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAddressPerLocale(t *testing.T) {
+	postalRE := regexp.MustCompile(`^[0-9]{5}$`)
+	for _, loc := range Locales() {
+		g, err := Default().WithLocale(loc)
+		if err != nil {
+			t.Fatalf("WithLocale error: %v", err)
+		}
+		for i := 0; i < 100; i++ {
+			a, err := g.Address()
+			if err != nil {
+				t.Fatalf("Address error: %v", err)
+			}
+			if a.Street == "" || a.City == "" || a.Region == "" || a.Country == "" {
+				t.Fatalf("%s: incomplete address %+v", loc, a)
+			}
+			if a.CountryCode != loc.Region() {
+				t.Fatalf("%s: CountryCode=%s", loc, a.CountryCode)
+			}
+			if !postalRE.MatchString(a.PostalCode) {
+				t.Fatalf("%s: PostalCode=%q", loc, a.PostalCode)
+			}
+			if !strings.Contains(a.String(), a.City) || !strings.ContainsAny(a.Street, "0123456789") {
+				t.Fatalf("%s: String=%q", loc, a.String())
+			}
+		}
+	}
+}
+
+func TestAddressPostalPrefixMatchesCity(t *testing.T) {
+	prefixes := map[string]string{}
+	for _, line := range LocaleEnUS.lines("cities") {
+		f := strings.Split(line, "\t")
+		prefixes[f[0]] = f[2]
+	}
+	for i := 0; i < 100; i++ {
+		a, err := Address()
+		if err != nil {
+			t.Fatalf("Address error: %v", err)
+		}
+		if !strings.HasPrefix(a.PostalCode, prefixes[a.City]) {
+			t.Fatalf("postal code %s does not match %s", a.PostalCode, a.City)
+		}
+	}
+}
+
+func TestPhoneNumbers(t *testing.T) {
+	cases := map[string]struct{ national, e164 *regexp.Regexp }{
+		"US": {regexp.MustCompile(`^\([2-9][0-8][0-9]\) 555-01[0-9]{2}$`), regexp.MustCompile(`^\+1[2-9][0-8][0-9]55501[0-9]{2}$`)},
+		"DE": {regexp.MustCompile(`^01[5-7][0-9] [0-9]{7,8}$`), regexp.MustCompile(`^\+491[5-7][0-9]{8,9}$`)},
+		"FR": {regexp.MustCompile(`^0[67]( [0-9]{2}){4}$`), regexp.MustCompile(`^\+33[67][0-9]{8}$`)},
+		"ES": {regexp.MustCompile(`^6[0-9]{2} [0-9]{3} [0-9]{3}$`), regexp.MustCompile(`^\+346[0-9]{8}$`)},
+	}
+	for region, re := range cases {
+		for i := 0; i < 200; i++ {
+			n, err := PhoneNumber(region)
+			if err != nil {
+				t.Fatalf("PhoneNumber(%s) error: %v", region, err)
+			}
+			if !re.national.MatchString(n) {
+				t.Fatalf("PhoneNumber(%s)=%q", region, n)
+			}
+			if region == "US" && n[2:4] == "11" {
+				t.Fatalf("N11 area code in %q", n)
+			}
+			e, err := PhoneNumberE164(region)
+			if err != nil {
+				t.Fatalf("PhoneNumberE164(%s) error: %v", region, err)
+			}
+			if !re.e164.MatchString(e) || len(e) > 16 {
+				t.Fatalf("PhoneNumberE164(%s)=%q", region, e)
+			}
+		}
+	}
+}
+
+func TestPhoneNumberRegionDefaultsToLocale(t *testing.T) {
+	g, err := Default().WithLocale(LocaleFrFR)
+	if err != nil {
+		t.Fatalf("WithLocale error: %v", err)
+	}
+	e, err := g.PhoneNumberE164("")
+	if err != nil || !strings.HasPrefix(e, "+33") {
+		t.Fatalf("PhoneNumberE164=%q err=%v", e, err)
+	}
+	if _, err := PhoneNumber("ZZ"); !errors.Is(err, ErrUnknownRegion) {
+		t.Fatalf("err=%v want ErrUnknownRegion", err)
+	}
+}
@@ -0,0 +1,5 @@
+// Package fake generates realistic-looking test data such as person names
+// and usernames from embedded, locale-aware corpora. Output is meant for
+// fixtures and demos, never for identifying real people. Generators are
+// concurrency-safe iff the injected RNG is safe.
+package fake
@@ -0,0 +1,11 @@
+package fake
+
+import "errors"
+
+// Package-level errors for fake data generation.
+var (
+	ErrUnknownLocale  = errors.New("randutil: unknown locale")
+	ErrUnknownRegion  = errors.New("randutil: unsupported region")
+	ErrUnknownNetwork = errors.New("randutil: unknown card network")
+	ErrStatusClass    = errors.New("randutil: status class must be 0 or in [1,5]")
+)
@@ -0,0 +1,16 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ExampleFullName() {
+	name, err := FullName()
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	fmt.Println(strings.Count(name, " "))
+	// Output: 1
+}
@@ -0,0 +1,143 @@
+//go:build randutil_must
+// +build randutil_must
+
+package fake
+
+// MustFirstName returns a random given name. It panics on error.
+func MustFirstName() string {
+	s, err := FirstName()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustLastName returns a random family name. It panics on error.
+func MustLastName() string {
+	s, err := LastName()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustFullName returns a random "First Last" name. It panics on error.
+func MustFullName() string {
+	s, err := FullName()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustUsername returns a random username. It panics on error.
+func MustUsername() string {
+	s, err := Username()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustAddress returns a random postal address. It panics on error.
+func MustAddress() PostalAddress {
+	a, err := Address()
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// MustPhoneNumber returns a phone number for region in national format.
+// It panics on error.
+func MustPhoneNumber(region string) string {
+	s, err := PhoneNumber(region)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustPhoneNumberE164 returns a phone number for region in E.164 format.
+// It panics on error.
+func MustPhoneNumberE164(region string) string {
+	s, err := PhoneNumberE164(region)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustWords returns n random lorem ipsum words. It panics on error.
+func MustWords(n int) []string {
+	w, err := Words(n)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// MustSentence returns a lorem ipsum sentence of minWords to maxWords
+// words. It panics on error.
+func MustSentence(minWords, maxWords int) string {
+	s, err := Sentence(minWords, maxWords)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustParagraphs returns n lorem ipsum paragraphs. It panics on error.
+func MustParagraphs(n int) []string {
+	p, err := Paragraphs(n)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MustCreditCard returns a Luhn-valid test card number. It panics on error.
+func MustCreditCard(network CardNetwork) string {
+	s, err := CreditCard(network)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustIBAN returns an IBAN with valid check digits. It panics on error.
+func MustIBAN(country string) string {
+	s, err := IBAN(country)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustUserAgent returns a realistic User-Agent string. It panics on error.
+func MustUserAgent() string {
+	s, err := UserAgent()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustHTTPMethod returns a traffic-weighted HTTP method. It panics on error.
+func MustHTTPMethod() string {
+	s, err := HTTPMethod()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustStatusCode returns a common HTTP status code of the given class.
+// It panics on error.
+func MustStatusCode(class int) int {
+	c, err := StatusCode(class)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
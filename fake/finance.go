@@ -0,0 +1,211 @@
+package fake
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CardNetwork names a payment card network.
+type CardNetwork string
+
+// Supported card networks.
+const (
+	Visa       CardNetwork = "visa"
+	Mastercard CardNetwork = "mastercard"
+	Amex       CardNetwork = "amex"
+	Discover   CardNetwork = "discover"
+	JCB        CardNetwork = "jcb"
+)
+
+// cardSpec is a test-card prefix and the PAN length for a network.
+type cardSpec struct {
+	prefix string
+	length int
+}
+
+// cardSpecs use the prefixes of the test card numbers that payment
+// processors publish for sandbox use.
+var cardSpecs = map[CardNetwork]cardSpec{
+	Visa:       {prefix: "424242", length: 16},
+	Mastercard: {prefix: "555555", length: 16},
+	Amex:       {prefix: "3782", length: 15},
+	Discover:   {prefix: "601111", length: 16},
+	JCB:        {prefix: "353011", length: 16},
+}
+
+// CreditCard returns a Luhn-valid test card number for network from the
+// default generator.
+//
+// Parameters:
+//   - network: Card network, e.g. Visa.
+//
+// Returns:
+//   - string: The card number (PAN) without separators.
+//   - error: ErrUnknownNetwork for unsupported networks, or an RNG error.
+func CreditCard(network CardNetwork) (string, error) {
+	return Default().CreditCard(network)
+}
+
+// IBAN returns an IBAN with valid mod-97 check digits for country from the
+// default generator.
+//
+// Parameters:
+//   - country: ISO 3166-1 alpha-2 code ("DE", "ES", "FR", "GB" or "NL");
+//     empty selects the generator's locale region.
+//
+// Returns:
+//   - string: The IBAN in electronic format, without spaces.
+//   - error: ErrUnknownRegion for unsupported countries, or an RNG error.
+func IBAN(country string) (string, error) {
+	return Default().IBAN(country)
+}
+
+// CreditCard returns a Luhn-valid card number for network. Numbers start
+// with the prefix of a published sandbox test card and are meant for test
+// environments only: they are not guaranteed to be unissued, so never
+// submit them to a live payment gateway.
+func (g *Generator) CreditCard(network CardNetwork) (string, error) {
+	spec, ok := cardSpecs[network]
+	if !ok {
+		return "", ErrUnknownNetwork
+	}
+	body, err := g.digits(spec.length - len(spec.prefix) - 1)
+	if err != nil {
+		return "", err
+	}
+	pan := spec.prefix + body
+	return pan + string(luhnDigit(pan)), nil
+}
+
+// luhnDigit returns the Luhn check digit for payload.
+func luhnDigit(payload string) byte {
+	sum := 0
+	double := true
+	for i := len(payload) - 1; i >= 0; i-- {
+		d := int(payload[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10) // #nosec G115 -- value is in [0,9]
+}
+
+// bbanSpec builds a country's basic bank account number.
+type bbanSpec func(g *Generator) (string, error)
+
+var bbanSpecs = map[string]bbanSpec{
+	// DE: 8-digit bank code, 10-digit account.
+	"DE": func(g *Generator) (string, error) { return g.digits(18) },
+	// GB: 4-letter bank code, 6-digit sort code, 8-digit account.
+	"GB": func(g *Generator) (string, error) { return g.lettersDigits(4, 14) },
+	// NL: 4-letter bank code, 10-digit account.
+	"NL": func(g *Generator) (string, error) { return g.lettersDigits(4, 10) },
+	"FR": frBBAN,
+	"ES": esBBAN,
+}
+
+// IBAN returns an IBAN with valid check digits for country. National check
+// digits (French RIB key, Spanish DC) are computed too. The account numbers
+// are random and only intended for test systems.
+func (g *Generator) IBAN(country string) (string, error) {
+	if country == "" {
+		country = g.locale.Region()
+	}
+	build, ok := bbanSpecs[country]
+	if !ok {
+		return "", ErrUnknownRegion
+	}
+	bban, err := build(g)
+	if err != nil {
+		return "", err
+	}
+	check := 98 - mod97(bban+country+"00")
+	return country + twoDigits(check) + bban, nil
+}
+
+// frBBAN builds bank (5), branch (5), account (11) and RIB key (2).
+func frBBAN(g *Generator) (string, error) {
+	s, err := g.digits(21)
+	if err != nil {
+		return "", err
+	}
+	bank, _ := strconv.ParseInt(s[:5], 10, 64)
+	branch, _ := strconv.ParseInt(s[5:10], 10, 64)
+	account, _ := strconv.ParseInt(s[10:], 10, 64)
+	key := 97 - (89*bank+15*branch+3*account)%97
+	return s + twoDigits(int(key)), nil
+}
+
+// esBBAN builds bank (4), branch (4), two DC check digits and account (10).
+func esBBAN(g *Generator) (string, error) {
+	s, err := g.digits(18)
+	if err != nil {
+		return "", err
+	}
+	bankBranch, account := s[:8], s[8:]
+	dc := string(esCheckDigit("00"+bankBranch)) + string(esCheckDigit(account))
+	return bankBranch + dc + account, nil
+}
+
+// esCheckDigit computes a Spanish CCC control digit over ten digits.
+func esCheckDigit(ten string) byte {
+	weights := [10]int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += int(ten[i]-'0') * weights[i]
+	}
+	d := 11 - sum%11
+	switch d {
+	case 11:
+		d = 0
+	case 10:
+		d = 1
+	}
+	return byte('0' + d) // #nosec G115 -- d is in [0,9]
+}
+
+// mod97 computes the ISO 7064 MOD 97-10 remainder of s, mapping letters
+// A-Z to 10-35.
+func mod97(s string) int {
+	r := 0
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			r = (r*100 + int(c-'A') + 10) % 97
+		} else {
+			r = (r*10 + int(c-'0')) % 97
+		}
+	}
+	return r
+}
+
+func twoDigits(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
+
+// lettersDigits returns nLetters random upper-case letters followed by
+// nDigits random digits.
+func (g *Generator) lettersDigits(nLetters, nDigits int) (string, error) {
+	var b strings.Builder
+	b.Grow(nLetters + nDigits)
+	for i := 0; i < nLetters; i++ {
+		l, err := g.rng.Uint64n(26)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte('A' + byte(l)) // #nosec G115 -- l < 26
+	}
+	d, err := g.digits(nDigits)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(d)
+	return b.String(), nil
+}
@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func luhnValid(pan string) bool {
+	sum := 0
+	for i := 0; i < len(pan); i++ {
+		d := int(pan[len(pan)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func TestCreditCard(t *testing.T) {
+	for network, spec := range cardSpecs {
+		for i := 0; i < 100; i++ {
+			pan, err := CreditCard(network)
+			if err != nil {
+				t.Fatalf("CreditCard(%s) error: %v", network, err)
+			}
+			if len(pan) != spec.length || !strings.HasPrefix(pan, spec.prefix) {
+				t.Fatalf("CreditCard(%s)=%q", network, pan)
+			}
+			if !luhnValid(pan) {
+				t.Fatalf("CreditCard(%s)=%q fails Luhn", network, pan)
+			}
+		}
+	}
+	if _, err := CreditCard("diners"); !errors.Is(err, ErrUnknownNetwork) {
+		t.Fatalf("err=%v want ErrUnknownNetwork", err)
+	}
+}
+
+func TestLuhnDigitKnownCards(t *testing.T) {
+	for _, pan := range []string{"4242424242424242", "5555555555554444", "378282246310005", "6011111111111117"} {
+		if got := luhnDigit(pan[:len(pan)-1]); got != pan[len(pan)-1] {
+			t.Fatalf("luhnDigit(%s)=%c want %c", pan, got, pan[len(pan)-1])
+		}
+	}
+}
+
+func TestIBAN(t *testing.T) {
+	lengths := map[string]int{"DE": 22, "GB": 22, "NL": 18, "FR": 27, "ES": 24}
+	for country, want := range lengths {
+		for i := 0; i < 100; i++ {
+			iban, err := IBAN(country)
+			if err != nil {
+				t.Fatalf("IBAN(%s) error: %v", country, err)
+			}
+			if len(iban) != want || !strings.HasPrefix(iban, country) {
+				t.Fatalf("IBAN(%s)=%q", country, iban)
+			}
+			if mod97(iban[4:]+iban[:4]) != 1 {
+				t.Fatalf("IBAN(%s)=%q fails mod-97", country, iban)
+			}
+			switch country {
+			case "FR":
+				bban := iban[4:]
+				bank, _ := strconv.ParseInt(bban[:5], 10, 64)
+				branch, _ := strconv.ParseInt(bban[5:10], 10, 64)
+				account, _ := strconv.ParseInt(bban[10:21], 10, 64)
+				key, _ := strconv.ParseInt(bban[21:], 10, 64)
+				if (89*bank+15*branch+3*account+key)%97 != 0 {
+					t.Fatalf("bad RIB key in %s", iban)
+				}
+			case "ES":
+				bban := iban[4:]
+				if bban[8] != esCheckDigit("00"+bban[:8]) || bban[9] != esCheckDigit(bban[10:]) {
+					t.Fatalf("bad DC in %s", iban)
+				}
+			}
+		}
+	}
+	if _, err := IBAN("ZZ"); !errors.Is(err, ErrUnknownRegion) {
+		t.Fatalf("err=%v want ErrUnknownRegion", err)
+	}
+}
+
+func TestIBANKnownCheckDigits(t *testing.T) {
+	// GB82WEST12345698765432 is the example IBAN from ISO 13616.
+	if got := 98 - mod97("WEST12345698765432GB00"); got != 82 {
+		t.Fatalf("check digits=%d want 82", got)
+	}
+}
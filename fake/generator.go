@@ -0,0 +1,70 @@
+package fake
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Generator builds fake test data using a core RNG and a locale.
+//
+// Concurrency: safe for concurrent use if the underlying RNG is safe.
+type Generator struct {
+	rng    rng
+	locale Locale
+	words  []string
+}
+
+// New returns a fake Generator for LocaleEnUS. If rng is nil, crypto/rand is
+// used.
+func New(rng rng) *Generator {
+	if rng == nil {
+		rng = core.New(nil)
+	}
+	return &Generator{rng: rng, locale: LocaleEnUS}
+}
+
+// NewWithSource returns a fake Generator bound to src.
+func NewWithSource(src core.Source) *Generator {
+	return New(core.New(src))
+}
+
+var defaultGenerator = New(nil)
+
+// Default returns the package-wide default generator.
+func Default() *Generator {
+	return defaultGenerator
+}
+
+// WithLocale returns a generator sharing g's entropy source that draws from
+// the corpora of locale. Returns ErrUnknownLocale if locale is not one of
+// Locales.
+func (g *Generator) WithLocale(locale Locale) (*Generator, error) {
+	if !locale.valid() {
+		return nil, ErrUnknownLocale
+	}
+	c := *g
+	c.locale = locale
+	return &c, nil
+}
+
+// WithWords returns a generator sharing g's entropy source whose Words,
+// Sentence and Paragraphs draw from a copy of words instead of the built-in
+// lorem ipsum corpus. Returns core.ErrEmptyItems if words is empty.
+func (g *Generator) WithWords(words []string) (*Generator, error) {
+	if len(words) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	c := *g
+	c.words = append([]string(nil), words...)
+	return &c, nil
+}
+
+// Locale returns the generator's locale.
+func (g *Generator) Locale() Locale {
+	return g.locale
+}
+
+func (g *Generator) pick(words []string) (string, error) {
+	idx, err := g.rng.Uint64n(uint64(len(words)))
+	if err != nil {
+		return "", err
+	}
+	return words[idx], nil
+}
@@ -0,0 +1,190 @@
+package fake
+
+import "fmt"
+
+// uaTemplate renders one browser family's user agent with random versions.
+type uaTemplate func(g *Generator) (string, error)
+
+const (
+	chromeTail = "AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%d.0.0.0 Safari/537.36"
+	safariTail = "AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%d.%d Safari/605.1.15"
+)
+
+var uaTemplates = []uaTemplate{
+	chromeUA("Windows NT 10.0; Win64; x64"),
+	chromeUA("Macintosh; Intel Mac OS X 10_15_7"),
+	chromeUA("X11; Linux x86_64"),
+	func(g *Generator) (string, error) {
+		android, err := g.rng.IntRange(10, 14)
+		if err != nil {
+			return "", err
+		}
+		major, err := g.rng.IntRange(110, 131)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (Linux; Android %d; K) AppleWebKit/537.36 "+
+			"(KHTML, like Gecko) Chrome/%d.0.0.0 Mobile Safari/537.36", android, major), nil
+	},
+	func(g *Generator) (string, error) {
+		major, err := g.rng.IntRange(110, 131)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) "+chromeTail+" Edg/%d.0.0.0",
+			major, major), nil
+	},
+	firefoxUA("Windows NT 10.0; Win64; x64"),
+	firefoxUA("X11; Linux x86_64"),
+	firefoxUA("Macintosh; Intel Mac OS X 10.15"),
+	func(g *Generator) (string, error) {
+		major, minor, err := g.safariVersion()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) "+safariTail,
+			major, minor), nil
+	},
+	func(g *Generator) (string, error) {
+		major, minor, err := g.safariVersion()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (iPhone; CPU iPhone OS %d_%d like Mac OS X) "+
+			"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%d.%d Mobile/15E148 Safari/604.1",
+			major, minor, major, minor), nil
+	},
+}
+
+func chromeUA(platform string) uaTemplate {
+	return func(g *Generator) (string, error) {
+		major, err := g.rng.IntRange(110, 131)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (%s) "+chromeTail, platform, major), nil
+	}
+}
+
+func firefoxUA(platform string) uaTemplate {
+	return func(g *Generator) (string, error) {
+		major, err := g.rng.IntRange(115, 132)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Mozilla/5.0 (%s; rv:%d.0) Gecko/20100101 Firefox/%d.0",
+			platform, major, major), nil
+	}
+}
+
+func (g *Generator) safariVersion() (int, int, error) {
+	major, err := g.rng.IntRange(16, 18)
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := g.rng.IntRange(0, 6)
+	if err != nil {
+		return 0, 0, err
+	}
+	return major, minor, nil
+}
+
+// weightedValue is an outcome and its relative weight; the tables below
+// sum to 1000 so weights read as per-mille frequencies.
+type weightedValue[T any] struct {
+	value  T
+	weight uint64
+}
+
+// httpMethods approximate the method mix of typical web traffic.
+var httpMethods = []weightedValue[string]{
+	{"GET", 700}, {"POST", 200}, {"PUT", 40}, {"DELETE", 30},
+	{"PATCH", 20}, {"HEAD", 5}, {"OPTIONS", 5},
+}
+
+// statusCodes holds the common codes of each class with rough relative
+// frequencies; index 0 mixes the classes like a healthy service's logs.
+var statusCodes = [6][]weightedValue[int]{
+	{{200, 780}, {201, 40}, {204, 30}, {301, 20}, {302, 20}, {304, 30},
+		{400, 20}, {401, 15}, {403, 10}, {404, 25}, {429, 5}, {500, 3}, {502, 1}, {503, 1}},
+	{{100, 600}, {101, 400}},
+	{{200, 800}, {201, 100}, {202, 30}, {204, 70}},
+	{{301, 300}, {302, 350}, {303, 50}, {304, 250}, {307, 30}, {308, 20}},
+	{{400, 250}, {401, 200}, {403, 150}, {404, 300}, {409, 40}, {422, 40}, {429, 20}},
+	{{500, 500}, {502, 250}, {503, 200}, {504, 50}},
+}
+
+// UserAgent returns a realistic desktop or mobile browser User-Agent
+// string from the default generator.
+//
+// Returns:
+//   - string: A User-Agent header value.
+//   - error: An error if the RNG fails.
+func UserAgent() (string, error) {
+	return Default().UserAgent()
+}
+
+// HTTPMethod returns an HTTP method weighted like typical web traffic
+// (mostly GET, then POST) from the default generator.
+//
+// Returns:
+//   - string: An HTTP method, e.g. "GET".
+//   - error: An error if the RNG fails.
+func HTTPMethod() (string, error) {
+	return Default().HTTPMethod()
+}
+
+// StatusCode returns a common HTTP status code of the given class from the
+// default generator.
+//
+// Parameters:
+//   - class: 1 to 5 for 1xx to 5xx, or 0 for a realistic mix dominated
+//     by 200.
+//
+// Returns:
+//   - int: The status code.
+//   - error: ErrStatusClass for other classes, or an RNG error.
+func StatusCode(class int) (int, error) {
+	return Default().StatusCode(class)
+}
+
+// UserAgent returns a realistic browser User-Agent string.
+func (g *Generator) UserAgent() (string, error) {
+	idx, err := g.rng.Uint64n(uint64(len(uaTemplates)))
+	if err != nil {
+		return "", err
+	}
+	return uaTemplates[idx](g)
+}
+
+// HTTPMethod returns an HTTP method weighted like typical web traffic.
+func (g *Generator) HTTPMethod() (string, error) {
+	return pickWeighted(g, httpMethods)
+}
+
+// StatusCode returns a common HTTP status code of the given class.
+func (g *Generator) StatusCode(class int) (int, error) {
+	if class < 0 || class >= len(statusCodes) {
+		return 0, ErrStatusClass
+	}
+	return pickWeighted(g, statusCodes[class])
+}
+
+func pickWeighted[T any](g *Generator, table []weightedValue[T]) (T, error) {
+	var total uint64
+	for _, e := range table {
+		total += e.weight
+	}
+	u, err := g.rng.Uint64n(total)
+	if err != nil {
+		var z T
+		return z, err
+	}
+	for _, e := range table {
+		if u < e.weight {
+			return e.value, nil
+		}
+		u -= e.weight
+	}
+	return table[len(table)-1].value, nil
+}
@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	for i := 0; i < 300; i++ {
+		ua, err := UserAgent()
+		if err != nil {
+			t.Fatalf("UserAgent error: %v", err)
+		}
+		if !strings.HasPrefix(ua, "Mozilla/5.0 (") || strings.Contains(ua, "%!") {
+			t.Fatalf("UserAgent=%q", ua)
+		}
+		if !strings.Contains(ua, "Chrome/") && !strings.Contains(ua, "Firefox/") &&
+			!strings.Contains(ua, "Safari/") {
+			t.Fatalf("UserAgent=%q names no browser", ua)
+		}
+	}
+}
+
+func TestHTTPMethod(t *testing.T) {
+	counts := map[string]int{}
+	const n = 20000
+	for i := 0; i < n; i++ {
+		m, err := HTTPMethod()
+		if err != nil {
+			t.Fatalf("HTTPMethod error: %v", err)
+		}
+		counts[m]++
+	}
+	for m := range counts {
+		switch m {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete,
+			http.MethodPatch, http.MethodHead, http.MethodOptions:
+		default:
+			t.Fatalf("unexpected method %q", m)
+		}
+	}
+	if frac := float64(counts["GET"]) / n; frac < 0.67 || frac > 0.73 {
+		t.Fatalf("GET fraction=%.3f want about 0.7", frac)
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	for class := 1; class <= 5; class++ {
+		for i := 0; i < 200; i++ {
+			c, err := StatusCode(class)
+			if err != nil {
+				t.Fatalf("StatusCode(%d) error: %v", class, err)
+			}
+			if c/100 != class || http.StatusText(c) == "" {
+				t.Fatalf("StatusCode(%d)=%d", class, c)
+			}
+		}
+	}
+	c, err := StatusCode(0)
+	if err != nil || http.StatusText(c) == "" {
+		t.Fatalf("StatusCode(0)=%d err=%v", c, err)
+	}
+	for _, class := range []int{-1, 6} {
+		if _, err := StatusCode(class); !errors.Is(err, ErrStatusClass) {
+			t.Fatalf("StatusCode(%d) err=%v want ErrStatusClass", class, err)
+		}
+	}
+}
+
+func TestWeightTablesSumTo1000(t *testing.T) {
+	sum := func(ws []uint64) uint64 {
+		var s uint64
+		for _, w := range ws {
+			s += w
+		}
+		return s
+	}
+	var ws []uint64
+	for _, e := range httpMethods {
+		ws = append(ws, e.weight)
+	}
+	if sum(ws) != 1000 {
+		t.Fatalf("httpMethods sum=%d", sum(ws))
+	}
+	for class, table := range statusCodes {
+		ws = ws[:0]
+		for _, e := range table {
+			ws = append(ws, e.weight)
+		}
+		if sum(ws) != 1000 {
+			t.Fatalf("statusCodes[%d] sum=%d", class, sum(ws))
+		}
+	}
+}
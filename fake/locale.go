@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/aatuh/randutil/v2/internal/corpus"
+)
+
+// Locale names a set of embedded corpora, in language_REGION form.
+type Locale string
+
+// Supported locales.
+const (
+	LocaleEnUS Locale = "en_US"
+	LocaleDeDE Locale = "de_DE"
+	LocaleFrFR Locale = "fr_FR"
+	LocaleEsES Locale = "es_ES"
+)
+
+var locales = []Locale{LocaleEnUS, LocaleDeDE, LocaleFrFR, LocaleEsES}
+
+// Locales returns the supported locales.
+func Locales() []Locale {
+	return slices.Clone(locales)
+}
+
+func (l Locale) valid() bool {
+	return slices.Contains(locales, l)
+}
+
+// Region returns the ISO 3166-1 alpha-2 country code of l, e.g. "US".
+func (l Locale) Region() string {
+	_, region, _ := strings.Cut(string(l), "_")
+	return region
+}
+
+// lines returns the embedded corpus file name for l.
+func (l Locale) lines(name string) []string {
+	return corpus.Lines(string(l) + "/" + name + ".txt")
+}
@@ -0,0 +1,135 @@
+package fake
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/corpus"
+)
+
+// Sentence and paragraph shapes used by Paragraphs.
+const (
+	paragraphMinSentences = 3
+	paragraphMaxSentences = 7
+	sentenceMinWords      = 4
+	sentenceMaxWords      = 12
+)
+
+// Words returns n random lorem ipsum words from the default generator.
+//
+// Parameters:
+//   - n: Number of words.
+//
+// Returns:
+//   - []string: The words.
+//   - error: ErrNegativeLength if n < 0, or an RNG error.
+func Words(n int) ([]string, error) {
+	return Default().Words(n)
+}
+
+// Sentence returns a capitalised lorem ipsum sentence ending in a period,
+// with a random word count in [minWords, maxWords], from the default
+// generator.
+//
+// Parameters:
+//   - minWords: Minimum number of words, at least 1.
+//   - maxWords: Maximum number of words.
+//
+// Returns:
+//   - string: The sentence.
+//   - error: ErrNonPositiveBound, ErrMinGreaterThanMax, or an RNG error.
+func Sentence(minWords, maxWords int) (string, error) {
+	return Default().Sentence(minWords, maxWords)
+}
+
+// Paragraphs returns n lorem ipsum paragraphs of three to seven sentences
+// from the default generator.
+//
+// Parameters:
+//   - n: Number of paragraphs.
+//
+// Returns:
+//   - []string: The paragraphs.
+//   - error: ErrNegativeLength if n < 0, or an RNG error.
+func Paragraphs(n int) ([]string, error) {
+	return Default().Paragraphs(n)
+}
+
+// Words returns n random words from g's word corpus. For reproducible
+// content, build g over a deterministic source such as
+// adapters.DeterministicSource.
+func (g *Generator) Words(n int) ([]string, error) {
+	if n < 0 {
+		return nil, &core.ArgError{Op: "Words", Arg: "n", Value: n, Err: core.ErrNegativeLength}
+	}
+	pool := g.wordCorpus()
+	out := make([]string, n)
+	for i := range out {
+		w, err := g.pick(pool)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = w
+	}
+	return out, nil
+}
+
+// Sentence returns a capitalised sentence of minWords to maxWords words.
+func (g *Generator) Sentence(minWords, maxWords int) (string, error) {
+	if minWords < 1 {
+		return "", &core.ArgError{Op: "Sentence", Arg: "minWords", Value: minWords, Err: core.ErrNonPositiveBound}
+	}
+	if minWords > maxWords {
+		return "", &core.RangeError{Op: "Sentence", Min: minWords, Max: maxWords, Err: core.ErrMinGreaterThanMax}
+	}
+	n, err := g.rng.IntRange(minWords, maxWords)
+	if err != nil {
+		return "", err
+	}
+	words, err := g.Words(n)
+	if err != nil {
+		return "", err
+	}
+	words[0] = capitalize(words[0])
+	return strings.Join(words, " ") + ".", nil
+}
+
+// Paragraphs returns n paragraphs of three to seven sentences each.
+func (g *Generator) Paragraphs(n int) ([]string, error) {
+	if n < 0 {
+		return nil, &core.ArgError{Op: "Paragraphs", Arg: "n", Value: n, Err: core.ErrNegativeLength}
+	}
+	out := make([]string, n)
+	for i := range out {
+		count, err := g.rng.IntRange(paragraphMinSentences, paragraphMaxSentences)
+		if err != nil {
+			return nil, err
+		}
+		sentences := make([]string, count)
+		for j := range sentences {
+			sentences[j], err = g.Sentence(sentenceMinWords, sentenceMaxWords)
+			if err != nil {
+				return nil, err
+			}
+		}
+		out[i] = strings.Join(sentences, " ")
+	}
+	return out, nil
+}
+
+func (g *Generator) wordCorpus() []string {
+	if g.words != nil {
+		return g.words
+	}
+	return corpus.Lines("lorem.txt")
+}
+
+func capitalize(w string) string {
+	r, size := utf8.DecodeRuneInString(w)
+	if r == utf8.RuneError {
+		return w
+	}
+	return string(unicode.ToUpper(r)) + w[size:]
+}
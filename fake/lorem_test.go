@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestWords(t *testing.T) {
+	words, err := Words(50)
+	if err != nil {
+		t.Fatalf("Words error: %v", err)
+	}
+	if len(words) != 50 {
+		t.Fatalf("len=%d want 50", len(words))
+	}
+	for _, w := range words {
+		if w == "" || strings.ContainsAny(w, " .") {
+			t.Fatalf("bad word %q", w)
+		}
+	}
+	if _, err := Words(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+}
+
+func TestSentence(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		s, err := Sentence(3, 6)
+		if err != nil {
+			t.Fatalf("Sentence error: %v", err)
+		}
+		if !strings.HasSuffix(s, ".") || !unicode.IsUpper([]rune(s)[0]) {
+			t.Fatalf("Sentence=%q", s)
+		}
+		if n := len(strings.Fields(s)); n < 3 || n > 6 {
+			t.Fatalf("Sentence has %d words: %q", n, s)
+		}
+	}
+	if _, err := Sentence(0, 3); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := Sentence(4, 3); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	ps, err := Paragraphs(3)
+	if err != nil {
+		t.Fatalf("Paragraphs error: %v", err)
+	}
+	if len(ps) != 3 {
+		t.Fatalf("len=%d want 3", len(ps))
+	}
+	for _, p := range ps {
+		if n := strings.Count(p, "."); n < paragraphMinSentences || n > paragraphMaxSentences {
+			t.Fatalf("paragraph has %d sentences: %q", n, p)
+		}
+	}
+}
+
+func TestWithWordsAndDeterminism(t *testing.T) {
+	custom := []string{"alpha", "beta", "gamma"}
+	newGen := func() *Generator {
+		g, err := New(core.New(testutil.NewSeqReader([]byte("fixed entropy stream for lorem")))).WithWords(custom)
+		if err != nil {
+			t.Fatalf("WithWords error: %v", err)
+		}
+		return g
+	}
+	a, err := newGen().Words(8)
+	if err != nil {
+		t.Fatalf("Words error: %v", err)
+	}
+	b, err := newGen().Words(8)
+	if err != nil {
+		t.Fatalf("Words error: %v", err)
+	}
+	if !slices.Equal(a, b) {
+		t.Fatalf("same source gave %v and %v", a, b)
+	}
+	for _, w := range a {
+		if !slices.Contains(custom, w) {
+			t.Fatalf("word %q not from custom corpus", w)
+		}
+	}
+	if _, err := Default().WithWords(nil); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("err=%v want ErrEmptyItems", err)
+	}
+}
@@ -0,0 +1,133 @@
+package fake
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// FirstName returns a random given name from the default generator.
+//
+// Returns:
+//   - string: A given name, e.g. "Jennifer".
+//   - error: An error if the RNG fails.
+func FirstName() (string, error) {
+	return Default().FirstName()
+}
+
+// LastName returns a random family name from the default generator.
+//
+// Returns:
+//   - string: A family name, e.g. "Smith".
+//   - error: An error if the RNG fails.
+func LastName() (string, error) {
+	return Default().LastName()
+}
+
+// FullName returns a random "First Last" name from the default generator.
+//
+// Returns:
+//   - string: A full name, e.g. "Jennifer Smith".
+//   - error: An error if the RNG fails.
+func FullName() (string, error) {
+	return Default().FullName()
+}
+
+// Username returns a random lowercase ASCII username derived from a name,
+// from the default generator.
+//
+// Returns:
+//   - string: A username, e.g. "jennifer.smith84".
+//   - error: An error if the RNG fails.
+func Username() (string, error) {
+	return Default().Username()
+}
+
+// FirstName returns a random given name for g's locale.
+func (g *Generator) FirstName() (string, error) {
+	return g.pick(g.locale.lines("first"))
+}
+
+// LastName returns a random family name for g's locale.
+func (g *Generator) LastName() (string, error) {
+	return g.pick(g.locale.lines("last"))
+}
+
+// FullName returns a random "First Last" name for g's locale.
+func (g *Generator) FullName() (string, error) {
+	first, err := g.FirstName()
+	if err != nil {
+		return "", err
+	}
+	last, err := g.LastName()
+	if err != nil {
+		return "", err
+	}
+	return first + " " + last, nil
+}
+
+// usernameShapes is the number of username shapes Username picks from.
+const usernameShapes = 5
+
+// Username returns a random lowercase ASCII username derived from a name in
+// g's locale. Accented letters are folded to ASCII ("Jürgen" -> "juergen").
+func (g *Generator) Username() (string, error) {
+	first, err := g.FirstName()
+	if err != nil {
+		return "", err
+	}
+	last, err := g.LastName()
+	if err != nil {
+		return "", err
+	}
+	first, last = asciiFold(first), asciiFold(last)
+	shape, err := g.rng.Uint64n(usernameShapes)
+	if err != nil {
+		return "", err
+	}
+	n, err := g.rng.IntRange(1, 99)
+	if err != nil {
+		return "", err
+	}
+	switch shape {
+	case 0:
+		return first + "." + last, nil
+	case 1:
+		return first + "." + last + strconv.Itoa(n), nil
+	case 2:
+		return first[:1] + last, nil
+	case 3:
+		return first + "_" + last + strconv.Itoa(n), nil
+	default:
+		return first + last[:1] + strconv.Itoa(n), nil
+	}
+}
+
+// foldings spells out letters that do not reduce to a single ASCII letter
+// by dropping their diacritic.
+var foldings = map[rune]string{
+	'ä': "ae", 'ö': "oe", 'ü': "ue", 'ß': "ss", 'æ': "ae", 'œ': "oe",
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'å': "a",
+	'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ø': "o",
+	'ù': "u", 'ú': "u", 'û': "u",
+	'ý': "y", 'ÿ': "y",
+}
+
+// asciiFold lower-cases s and maps it to [a-z0-9], dropping anything else.
+func asciiFold(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r > unicode.MaxASCII:
+			b.WriteString(foldings[r])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,88 @@
+package fake
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+var usernameRE = regexp.MustCompile(`^[a-z]+([._]?[a-z]+)?[0-9]*$`)
+
+func TestNamesPerLocale(t *testing.T) {
+	for _, loc := range Locales() {
+		g, err := Default().WithLocale(loc)
+		if err != nil {
+			t.Fatalf("WithLocale(%s) error: %v", loc, err)
+		}
+		if g.Locale() != loc {
+			t.Fatalf("Locale=%s want %s", g.Locale(), loc)
+		}
+		for i := 0; i < 200; i++ {
+			first, err := g.FirstName()
+			if err != nil {
+				t.Fatalf("FirstName error: %v", err)
+			}
+			if !slices.Contains(loc.lines("first"), first) {
+				t.Fatalf("%s: %q not in first-name corpus", loc, first)
+			}
+			full, err := g.FullName()
+			if err != nil {
+				t.Fatalf("FullName error: %v", err)
+			}
+			if strings.Count(full, " ") != 1 {
+				t.Fatalf("%s: FullName=%q", loc, full)
+			}
+			u, err := g.Username()
+			if err != nil {
+				t.Fatalf("Username error: %v", err)
+			}
+			if !usernameRE.MatchString(u) {
+				t.Fatalf("%s: Username=%q is not lowercase ASCII", loc, u)
+			}
+		}
+	}
+}
+
+func TestWithLocaleDoesNotMutate(t *testing.T) {
+	g := New(nil)
+	de, err := g.WithLocale(LocaleDeDE)
+	if err != nil {
+		t.Fatalf("WithLocale error: %v", err)
+	}
+	if g.Locale() != LocaleEnUS || de.Locale() != LocaleDeDE {
+		t.Fatalf("locales %s/%s", g.Locale(), de.Locale())
+	}
+	if _, err := g.WithLocale("xx_XX"); !errors.Is(err, ErrUnknownLocale) {
+		t.Fatalf("err=%v want ErrUnknownLocale", err)
+	}
+}
+
+func TestASCIIFold(t *testing.T) {
+	cases := map[string]string{
+		"Jürgen":   "juergen",
+		"Weiß":     "weiss",
+		"Élodie":   "elodie",
+		"Núñez":    "nunez",
+		"O'Brien":  "obrien",
+		"Anaïs":    "anais",
+		"Lefèbvre": "lefebvre",
+	}
+	for in, want := range cases {
+		if got := asciiFold(in); got != want {
+			t.Fatalf("asciiFold(%q)=%q want %q", in, got, want)
+		}
+	}
+}
+
+func TestNamesPropagateRNGError(t *testing.T) {
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.Username(); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
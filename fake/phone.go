@@ -0,0 +1,151 @@
+package fake
+
+import "strconv"
+
+// phoneSpec describes how to build and format a region's phone numbers.
+type phoneSpec struct {
+	countryCode string
+	// national draws the national significant number and returns it with
+	// its national formatting.
+	national func(g *Generator) (nsn, formatted string, err error)
+}
+
+var phoneSpecs = map[string]phoneSpec{
+	"US": {countryCode: "1", national: usPhone},
+	"DE": {countryCode: "49", national: dePhone},
+	"FR": {countryCode: "33", national: frPhone},
+	"ES": {countryCode: "34", national: esPhone},
+}
+
+// PhoneNumber returns a structurally valid phone number for region in
+// national format from the default generator.
+//
+// Parameters:
+//   - region: ISO 3166-1 alpha-2 code ("US", "DE", "FR" or "ES"); empty
+//     selects the generator's locale region.
+//
+// Returns:
+//   - string: A phone number, e.g. "(212) 555-0147" or "0151 23456789".
+//   - error: ErrUnknownRegion for unsupported regions, or an RNG error.
+func PhoneNumber(region string) (string, error) {
+	return Default().PhoneNumber(region)
+}
+
+// PhoneNumberE164 returns a structurally valid phone number for region in
+// E.164 format from the default generator.
+//
+// Parameters:
+//   - region: ISO 3166-1 alpha-2 code; empty selects the generator's
+//     locale region.
+//
+// Returns:
+//   - string: A phone number, e.g. "+12125550147".
+//   - error: ErrUnknownRegion for unsupported regions, or an RNG error.
+func PhoneNumberE164(region string) (string, error) {
+	return Default().PhoneNumberE164(region)
+}
+
+// PhoneNumber returns a phone number for region in national format. US
+// numbers use the 555-0100 through 555-0199 range reserved for fiction;
+// other regions use mobile prefixes.
+func (g *Generator) PhoneNumber(region string) (string, error) {
+	spec, err := g.phoneSpec(region)
+	if err != nil {
+		return "", err
+	}
+	_, formatted, err := spec.national(g)
+	return formatted, err
+}
+
+// PhoneNumberE164 returns a phone number for region in E.164 format.
+func (g *Generator) PhoneNumberE164(region string) (string, error) {
+	spec, err := g.phoneSpec(region)
+	if err != nil {
+		return "", err
+	}
+	nsn, _, err := spec.national(g)
+	if err != nil {
+		return "", err
+	}
+	return "+" + spec.countryCode + nsn, nil
+}
+
+func (g *Generator) phoneSpec(region string) (phoneSpec, error) {
+	if region == "" {
+		region = g.locale.Region()
+	}
+	spec, ok := phoneSpecs[region]
+	if !ok {
+		return phoneSpec{}, ErrUnknownRegion
+	}
+	return spec, nil
+}
+
+// usPhone draws a NANP number NXX-555-01XX: the area code starts with 2-9,
+// avoids N11 service codes and the reserved N9X range.
+func usPhone(g *Generator) (string, string, error) {
+	var area string
+	for {
+		a, err := g.digits(3)
+		if err != nil {
+			return "", "", err
+		}
+		if a[0] < '2' || a[1] == '9' || (a[1] == '1' && a[2] == '1') {
+			continue
+		}
+		area = a
+		break
+	}
+	line, err := g.digits(2)
+	if err != nil {
+		return "", "", err
+	}
+	nsn := area + "55501" + line
+	return nsn, "(" + area + ") 555-01" + line, nil
+}
+
+// deMobilePrefixes are German mobile network prefixes without the trunk 0.
+var deMobilePrefixes = []string{
+	"151", "152", "157", "159", "160", "162", "163",
+	"170", "171", "172", "173", "174", "175", "176", "177", "178", "179",
+}
+
+func dePhone(g *Generator) (string, string, error) {
+	prefix, err := g.pick(deMobilePrefixes)
+	if err != nil {
+		return "", "", err
+	}
+	// 015x numbers have eight subscriber digits, 016x and 017x seven.
+	n := 7
+	if prefix[1] == '5' {
+		n = 8
+	}
+	sub, err := g.digits(n)
+	if err != nil {
+		return "", "", err
+	}
+	return prefix + sub, "0" + prefix + " " + sub, nil
+}
+
+func frPhone(g *Generator) (string, string, error) {
+	first, err := g.rng.IntRange(6, 7)
+	if err != nil {
+		return "", "", err
+	}
+	rest, err := g.digits(8)
+	if err != nil {
+		return "", "", err
+	}
+	nsn := strconv.Itoa(first) + rest
+	return nsn, "0" + nsn[:1] + " " + rest[0:2] + " " + rest[2:4] + " " +
+		rest[4:6] + " " + rest[6:8], nil
+}
+
+func esPhone(g *Generator) (string, string, error) {
+	rest, err := g.digits(8)
+	if err != nil {
+		return "", "", err
+	}
+	nsn := "6" + rest
+	return nsn, nsn[0:3] + " " + nsn[3:6] + " " + nsn[6:9], nil
+}
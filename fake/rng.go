@@ -0,0 +1,6 @@
+package fake
+
+type rng interface {
+	Uint64n(n uint64) (uint64, error)
+	IntRange(minInclusive, maxInclusive int) (int, error)
+}
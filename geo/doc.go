@@ -0,0 +1,6 @@
+// Package geo generates random geographic coordinates on a spherical Earth:
+// anywhere on the globe, within a radius of a point, or inside a latitude/
+// longitude bounding box. Points are uniform by surface area, so they do not
+// cluster near the poles the way uniform latitudes would. Generators are
+// concurrency-safe iff the injected RNG is safe.
+package geo
@@ -0,0 +1,9 @@
+package geo
+
+import "errors"
+
+// Package-level errors for coordinate generation.
+var (
+	ErrInvalidLatitude  = errors.New("randutil: latitude must be in [-90,90]")
+	ErrInvalidLongitude = errors.New("randutil: longitude must be in [-180,180]")
+)
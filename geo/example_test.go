@@ -0,0 +1,14 @@
+package geo
+
+import "fmt"
+
+func ExamplePointInBoundingBox() {
+	// Somewhere in Finland.
+	p, err := PointInBoundingBox(59.8, 20.6, 70.1, 31.6)
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	fmt.Println(p.Lat >= 59.8 && p.Lat <= 70.1)
+	// Output: true
+}
@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// LatLong returns a point uniformly distributed over the Earth's surface
+// from the default generator.
+//
+// Returns:
+//   - Point: Latitude in [-90, 90] and longitude in [-180, 180).
+//   - error: An error if the RNG fails.
+func LatLong() (Point, error) {
+	return Default().LatLong()
+}
+
+// LatLong returns a point uniformly distributed over the Earth's surface.
+// The sine of the latitude is uniform, so equal areas are equally likely.
+func (g *Generator) LatLong() (Point, error) {
+	return g.inBox(-90, 90, -180, 360)
+}
+
+// PointInBoundingBox returns a point uniformly distributed by area inside
+// the box from the default generator.
+//
+// Parameters:
+//   - minLat, maxLat: Latitude bounds in [-90, 90] with minLat <= maxLat.
+//   - minLon, maxLon: Longitude bounds in [-180, 180]. If minLon > maxLon
+//     the box crosses the antimeridian.
+//
+// Returns:
+//   - Point: A point inside the box, longitude normalized to [-180, 180).
+//   - error: ErrInvalidLatitude, ErrInvalidLongitude, ErrMinGreaterThanMax
+//     for inverted latitudes, or an RNG error.
+func PointInBoundingBox(minLat, minLon, maxLat, maxLon float64) (Point, error) {
+	return Default().PointInBoundingBox(minLat, minLon, maxLat, maxLon)
+}
+
+// PointInBoundingBox returns a point uniformly distributed by area inside
+// the box. A box with minLon > maxLon wraps across the antimeridian.
+func (g *Generator) PointInBoundingBox(minLat, minLon, maxLat, maxLon float64) (Point, error) {
+	const op = "PointInBoundingBox"
+	if err := checkLat(op, "minLat", minLat); err != nil {
+		return Point{}, err
+	}
+	if err := checkLat(op, "maxLat", maxLat); err != nil {
+		return Point{}, err
+	}
+	if err := checkLon(op, "minLon", minLon); err != nil {
+		return Point{}, err
+	}
+	if err := checkLon(op, "maxLon", maxLon); err != nil {
+		return Point{}, err
+	}
+	if minLat > maxLat {
+		return Point{}, &core.RangeError{Op: op, Min: minLat, Max: maxLat, Err: core.ErrMinGreaterThanMax}
+	}
+	width := maxLon - minLon
+	if width < 0 {
+		width += 360
+	}
+	return g.inBox(minLat, maxLat, minLon, width)
+}
+
+// inBox draws sin(lat) uniformly between the latitude bounds and the
+// longitude uniformly in [minLon, minLon+width).
+func (g *Generator) inBox(minLat, maxLat, minLon, width float64) (Point, error) {
+	u, err := g.rng.Float64()
+	if err != nil {
+		return Point{}, err
+	}
+	v, err := g.rng.Float64()
+	if err != nil {
+		return Point{}, err
+	}
+	lo, hi := math.Sin(radians(minLat)), math.Sin(radians(maxLat))
+	lat := clampLat(degrees(math.Asin(lo + u*(hi-lo))))
+	lat = math.Max(minLat, math.Min(maxLat, lat))
+	lon := minLon + v*width
+	if lon >= 180 || lon < -180 {
+		lon = normalizeLon(lon)
+	}
+	return Point{Lat: lat, Lon: lon}, nil
+}
@@ -0,0 +1,34 @@
+//go:build randutil_must
+// +build randutil_must
+
+package geo
+
+// MustLatLong returns a uniformly random point on the globe. It panics on
+// error.
+func MustLatLong() Point {
+	p, err := LatLong()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MustPointInRadius returns a random point within meters of (lat, lon). It
+// panics on error.
+func MustPointInRadius(lat, lon, meters float64) Point {
+	p, err := PointInRadius(lat, lon, meters)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MustPointInBoundingBox returns a random point inside the box. It panics on
+// error.
+func MustPointInBoundingBox(minLat, minLon, maxLat, maxLon float64) Point {
+	p, err := PointInBoundingBox(minLat, minLon, maxLat, maxLon)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
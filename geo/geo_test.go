@@ -0,0 +1,129 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func haversine(a, b Point) float64 {
+	dLat := radians(b.Lat - a.Lat)
+	dLon := radians(b.Lon - a.Lon)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(radians(a.Lat))*math.Cos(radians(b.Lat))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func TestLatLongUniformByArea(t *testing.T) {
+	const n = 20000
+	polar := 0
+	for i := 0; i < n; i++ {
+		p, err := LatLong()
+		if err != nil {
+			t.Fatalf("LatLong error: %v", err)
+		}
+		if p.Lat < -90 || p.Lat > 90 || p.Lon < -180 || p.Lon >= 180 {
+			t.Fatalf("LatLong out of range: %v", p)
+		}
+		if math.Abs(p.Lat) > 60 {
+			polar++
+		}
+	}
+	// Area above |lat| = 60 is 1 - sin(60deg) ~= 0.134 of the sphere; a
+	// uniform latitude would put a third of the points there.
+	want := 1 - math.Sin(radians(60))
+	if got := float64(polar) / n; math.Abs(got-want) > 0.015 {
+		t.Fatalf("polar fraction=%.4f want ~%.4f", got, want)
+	}
+}
+
+func TestPointInRadius(t *testing.T) {
+	centers := []Point{{60.1699, 24.9384}, {89.9, 0}, {-33.86, 151.2}, {0, 179.999}}
+	for _, c := range centers {
+		for _, r := range []float64{0, 1, 500, 250000} {
+			inner := 0
+			const n = 2000
+			for i := 0; i < n; i++ {
+				p, err := PointInRadius(c.Lat, c.Lon, r)
+				if err != nil {
+					t.Fatalf("PointInRadius error: %v", err)
+				}
+				if p.Lon < -180 || p.Lon >= 180 || p.Lat < -90 || p.Lat > 90 {
+					t.Fatalf("PointInRadius out of range: %v", p)
+				}
+				d := haversine(c, p)
+				if d > r*(1+1e-9)+1e-6 {
+					t.Fatalf("point %v is %.3fm from %v, radius %.0f", p, d, c, r)
+				}
+				if d <= r/2 {
+					inner++
+				}
+			}
+			// For small caps half the radius holds a quarter of the area.
+			if r > 0 {
+				if got := float64(inner) / n; math.Abs(got-0.25) > 0.05 {
+					t.Fatalf("inner fraction=%.3f for r=%.0f, want ~0.25", got, r)
+				}
+			}
+		}
+	}
+	p, err := PointInRadius(10, 20, math.Pi*EarthRadius*3)
+	if err != nil || p.Lat < -90 || p.Lat > 90 {
+		t.Fatalf("whole-globe radius: p=%v err=%v", p, err)
+	}
+}
+
+func TestPointInBoundingBox(t *testing.T) {
+	for i := 0; i < 2000; i++ {
+		p, err := PointInBoundingBox(59, 20, 70, 31)
+		if err != nil {
+			t.Fatalf("PointInBoundingBox error: %v", err)
+		}
+		if p.Lat < 59 || p.Lat > 70 || p.Lon < 20 || p.Lon >= 31 {
+			t.Fatalf("point %v outside box", p)
+		}
+		p, err = PointInBoundingBox(-20, 170, -10, -170)
+		if err != nil {
+			t.Fatalf("PointInBoundingBox error: %v", err)
+		}
+		if p.Lat < -20 || p.Lat > -10 || (p.Lon < 170 && p.Lon >= -170) {
+			t.Fatalf("point %v outside antimeridian box", p)
+		}
+	}
+}
+
+func TestGeoErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{"lat", func() error { _, err := PointInRadius(91, 0, 1); return err }, ErrInvalidLatitude},
+		{"lon", func() error { _, err := PointInRadius(0, -181, 1); return err }, ErrInvalidLongitude},
+		{"nan lat", func() error { _, err := PointInRadius(math.NaN(), 0, 1); return err }, ErrInvalidLatitude},
+		{"negative radius", func() error { _, err := PointInRadius(0, 0, -1); return err }, core.ErrNegativeLength},
+		{"inf radius", func() error { _, err := PointInRadius(0, 0, math.Inf(1)); return err }, core.ErrNonFiniteBound},
+		{"box lat", func() error { _, err := PointInBoundingBox(-95, 0, 0, 1); return err }, ErrInvalidLatitude},
+		{"box lon", func() error { _, err := PointInBoundingBox(0, 0, 1, 200); return err }, ErrInvalidLongitude},
+		{"box order", func() error { _, err := PointInBoundingBox(10, 0, 5, 1); return err }, core.ErrMinGreaterThanMax},
+	}
+	for _, tc := range cases {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.want)
+		}
+	}
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.LatLong(); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
+
+func TestPointString(t *testing.T) {
+	if got := (Point{Lat: 60.1699, Lon: -24.93845}).String(); got != "60.169900,-24.938450" {
+		t.Fatalf("String()=%q", got)
+	}
+}
@@ -0,0 +1,56 @@
+package geo
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// EarthRadius is the mean Earth radius in meters used for distance
+// calculations.
+const EarthRadius = 6371008.8
+
+// Point is a geographic coordinate in decimal degrees.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// String formats p as "lat,lon" with six decimal places (about 0.1 m).
+func (p Point) String() string {
+	return strconv.FormatFloat(p.Lat, 'f', 6, 64) + "," +
+		strconv.FormatFloat(p.Lon, 'f', 6, 64)
+}
+
+func checkLat(op, arg string, lat float64) error {
+	if !(lat >= -90 && lat <= 90) {
+		return &core.ArgError{Op: op, Arg: arg, Value: lat, Err: ErrInvalidLatitude}
+	}
+	return nil
+}
+
+func checkLon(op, arg string, lon float64) error {
+	if !(lon >= -180 && lon <= 180) {
+		return &core.ArgError{Op: op, Arg: arg, Value: lon, Err: ErrInvalidLongitude}
+	}
+	return nil
+}
+
+// normalizeLon wraps lon into [-180, 180).
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
+
+// clampLat guards against asin rounding just past the poles.
+func clampLat(lat float64) float64 {
+	return math.Max(-90, math.Min(90, lat))
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
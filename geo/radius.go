@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// PointInRadius returns a point uniformly distributed by area within
+// meters of (lat, lon) from the default generator.
+//
+// Parameters:
+//   - lat, lon: Center in decimal degrees.
+//   - meters: Great-circle radius; 0 returns the center, and radii of half
+//     the Earth's circumference or more cover the whole globe.
+//
+// Returns:
+//   - Point: A point at great-circle distance <= meters from the center.
+//   - error: ErrInvalidLatitude, ErrInvalidLongitude, ErrNegativeLength or
+//     ErrNonFiniteBound for a bad radius, or an RNG error.
+func PointInRadius(lat, lon, meters float64) (Point, error) {
+	return Default().PointInRadius(lat, lon, meters)
+}
+
+// PointInRadius returns a point uniformly distributed over the spherical
+// cap of radius meters around (lat, lon).
+func (g *Generator) PointInRadius(lat, lon, meters float64) (Point, error) {
+	const op = "PointInRadius"
+	if err := checkLat(op, "lat", lat); err != nil {
+		return Point{}, err
+	}
+	if err := checkLon(op, "lon", lon); err != nil {
+		return Point{}, err
+	}
+	if math.IsNaN(meters) || math.IsInf(meters, 0) {
+		return Point{}, &core.ArgError{Op: op, Arg: "meters", Value: meters, Err: core.ErrNonFiniteBound}
+	}
+	if meters < 0 {
+		return Point{}, &core.ArgError{Op: op, Arg: "meters", Value: meters, Err: core.ErrNegativeLength}
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return Point{}, err
+	}
+	v, err := g.rng.Float64()
+	if err != nil {
+		return Point{}, err
+	}
+
+	// Cap area grows with 1-cos(theta) = 2 sin^2(theta/2), so drawing that
+	// quantity uniformly gives a uniform point; the half-angle form keeps
+	// precision for small radii.
+	maxAngle := math.Min(meters/EarthRadius, math.Pi)
+	theta := 2 * math.Asin(math.Sqrt(u)*math.Sin(maxAngle/2))
+	bearing := 2 * math.Pi * v
+
+	phi1, lambda1 := radians(lat), radians(lon)
+	sinPhi2 := math.Sin(phi1)*math.Cos(theta) +
+		math.Cos(phi1)*math.Sin(theta)*math.Cos(bearing)
+	sinPhi2 = math.Max(-1, math.Min(1, sinPhi2))
+	phi2 := math.Asin(sinPhi2)
+	lambda2 := lambda1 + math.Atan2(
+		math.Sin(bearing)*math.Sin(theta)*math.Cos(phi1),
+		math.Cos(theta)-math.Sin(phi1)*sinPhi2,
+	)
+	return Point{
+		Lat: clampLat(degrees(phi2)),
+		Lon: normalizeLon(degrees(lambda2)),
+	}, nil
+}
@@ -0,0 +1,5 @@
+package geo
+
+type rng interface {
+	Float64() (float64, error)
+}
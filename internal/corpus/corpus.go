@@ -0,0 +1,35 @@
+// Package corpus embeds the word lists shared by the test-data generators
+// in email and fake.
+package corpus
+
+import (
+	"embed"
+	"strings"
+	"sync"
+)
+
+//go:embed data
+var data embed.FS
+
+var cache sync.Map // path -> []string
+
+// Lines returns the whitespace-trimmed, non-empty lines of the embedded file
+// data/<path>. The result is shared and must not be modified. Lines panics
+// if the file does not exist, since paths are fixed at compile time.
+func Lines(path string) []string {
+	if v, ok := cache.Load(path); ok {
+		return v.([]string)
+	}
+	raw, err := data.ReadFile("data/" + path)
+	if err != nil {
+		panic("corpus: " + err.Error())
+	}
+	var lines []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	v, _ := cache.LoadOrStore(path, lines)
+	return v.([]string)
+}
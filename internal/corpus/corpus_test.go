@@ -0,0 +1,39 @@
+package corpus
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestCorporaAreUnique(t *testing.T) {
+	err := fs.WalkDir(data, "data", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		lines := Lines(strings.TrimPrefix(path, "data/"))
+		if len(lines) == 0 {
+			t.Fatalf("%s is empty", path)
+		}
+		seen := map[string]bool{}
+		for _, l := range lines {
+			if seen[l] {
+				t.Fatalf("%s: duplicate %q", path, l)
+			}
+			seen[l] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkDir error: %v", err)
+	}
+}
+
+func TestLinesPanicsOnMissingFile(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Lines did not panic")
+		}
+	}()
+	Lines("missing.txt")
+}
@@ -1,7 +1,9 @@
 package nanoid
 
+import "github.com/aatuh/randutil/v2/randstring"
+
 // DefaultAlphabet is the NanoID default alphabet (64 characters).
-const DefaultAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+const DefaultAlphabet = randstring.NanoIDAlphabet
 
 // DefaultLength is the NanoID default length.
-const DefaultLength = 21
+const DefaultLength = randstring.NanoIDLength
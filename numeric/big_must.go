@@ -0,0 +1,36 @@
+//go:build randutil_must
+// +build randutil_must
+
+package numeric
+
+import "math/big"
+
+// MustBigIntBits returns a secure random big.Int in [0, 2^n).
+// It panics if an error occurs.
+func MustBigIntBits(n int) *big.Int {
+	v, err := BigIntBits(n)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustBigIntRange returns a secure random big.Int in [minInclusive,
+// maxInclusive]. It panics if an error occurs.
+func MustBigIntRange(minInclusive *big.Int, maxInclusive *big.Int) *big.Int {
+	v, err := BigIntRange(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustBigFloat returns a secure random big.Float in [0, 1) with prec bits of
+// mantissa. It panics if an error occurs.
+func MustBigFloat(prec uint) *big.Float {
+	v, err := BigFloat(prec)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,68 @@
+package numeric
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestBigIntBits(t *testing.T) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 70)
+	for i := 0; i < 100; i++ {
+		v, err := BigIntBits(70)
+		if err != nil || v.Sign() < 0 || v.Cmp(limit) >= 0 {
+			t.Fatalf("BigIntBits value: %v err: %v", v, err)
+		}
+	}
+	gen := NewWithSource(testutil.NewSeqReader([]byte{0xff}))
+	if v, err := gen.BigIntBits(12); err != nil || v.Int64() != 0xfff {
+		t.Fatalf("BigIntBits(12) = %v err: %v want 4095", v, err)
+	}
+	if v, err := gen.BigIntBits(0); err != nil || v.Sign() != 0 {
+		t.Fatalf("BigIntBits(0) = %v err: %v want 0", v, err)
+	}
+	if _, err := BigIntBits(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("BigIntBits(-1) err=%v want ErrNegativeLength", err)
+	}
+}
+
+func TestBigIntRange(t *testing.T) {
+	lo, _ := new(big.Int).SetString("-100000000000000000000", 10)
+	hi, _ := new(big.Int).SetString("100000000000000000000", 10)
+	loCopy := new(big.Int).Set(lo)
+	for i := 0; i < 100; i++ {
+		v, err := BigIntRange(lo, hi)
+		if err != nil || v.Cmp(lo) < 0 || v.Cmp(hi) > 0 {
+			t.Fatalf("BigIntRange value: %v err: %v", v, err)
+		}
+	}
+	if lo.Cmp(loCopy) != 0 {
+		t.Fatalf("BigIntRange modified its bound")
+	}
+	if _, err := BigIntRange(nil, hi); !errors.Is(err, core.ErrNilBound) {
+		t.Fatalf("nil bound err=%v want ErrNilBound", err)
+	}
+	if _, err := BigIntRange(hi, lo); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("inverted bounds err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	one := big.NewFloat(1)
+	for i := 0; i < 100; i++ {
+		f, err := BigFloat(200)
+		if err != nil || f.Sign() < 0 || f.Cmp(one) >= 0 || f.Prec() != 200 {
+			t.Fatalf("BigFloat value: %v prec %d err: %v", f, f.Prec(), err)
+		}
+	}
+	gen := NewWithSource(testutil.NewSeqReader([]byte{0x80}))
+	if f, err := gen.BigFloat(8); err != nil || f.Cmp(big.NewFloat(0.5)) != 0 {
+		t.Fatalf("BigFloat(8) = %v err: %v want 0.5", f, err)
+	}
+	if _, err := BigFloat(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("BigFloat(0) err=%v want ErrNonPositiveBound", err)
+	}
+}
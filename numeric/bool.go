@@ -1,5 +1,7 @@
 package numeric
 
+import "github.com/aatuh/randutil/v2/core"
+
 // Bool returns a secure random boolean.
 //
 // Returns:
@@ -11,3 +13,37 @@ func Bool() (bool, error) { return Default().Bool() }
 func (g *Generator) Bool() (bool, error) {
 	return g.rng.Bool()
 }
+
+// BoolWithProbability returns true with probability p and false otherwise.
+//
+// Parameters:
+//   - p: The probability of true, in [0, 1].
+//
+// Returns:
+//   - bool: A biased random boolean.
+//   - error: An error if p is not in [0, 1] or if crypto/rand fails.
+func BoolWithProbability(p float64) (bool, error) { return Default().BoolWithProbability(p) }
+
+// BoolWithProbability returns true with probability p using the generator.
+func (g *Generator) BoolWithProbability(p float64) (bool, error) {
+	if !(p >= 0 && p <= 1) {
+		return false, &core.ArgError{Op: "BoolWithProbability", Arg: "p", Value: p, Err: core.ErrInvalidProbability}
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return false, err
+	}
+	return u < p, nil
+}
+
+// Percent returns a secure random percentage.
+//
+// Returns:
+//   - int: A uniform random int in [0, 100].
+//   - error: An error if crypto/rand fails.
+func Percent() (int, error) { return Default().Percent() }
+
+// Percent returns a uniform random int in [0, 100] using the generator.
+func (g *Generator) Percent() (int, error) {
+	return g.rng.IntRange(0, 100)
+}
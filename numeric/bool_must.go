@@ -23,3 +23,43 @@ func (g *Generator) MustBool() bool {
 	}
 	return b
 }
+
+// MustBoolWithProbability returns true with probability p. It panics if an
+// error occurs.
+//
+// Parameters:
+//   - p: The probability of true, in [0, 1].
+//
+// Returns:
+//   - bool: A biased random boolean.
+func MustBoolWithProbability(p float64) bool {
+	return Default().MustBoolWithProbability(p)
+}
+
+// MustBoolWithProbability returns true with probability p. It panics on
+// error.
+func (g *Generator) MustBoolWithProbability(p float64) bool {
+	b, err := g.BoolWithProbability(p)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// MustPercent returns a secure random int in [0, 100]. It panics if an error
+// occurs.
+//
+// Returns:
+//   - int: A uniform random int in [0, 100].
+func MustPercent() int {
+	return Default().MustPercent()
+}
+
+// MustPercent returns a random int in [0, 100]. It panics on error.
+func (g *Generator) MustPercent() int {
+	v, err := g.Percent()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
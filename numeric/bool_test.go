@@ -1,6 +1,13 @@
 package numeric
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
 
 func TestBoolReturnsValue(t *testing.T) {
 	b, err := Bool()
@@ -11,3 +18,34 @@ func TestBoolReturnsValue(t *testing.T) {
 		t.Fatalf("Bool produced non-boolean value: %v", b)
 	}
 }
+
+func TestBoolWithProbability(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		if b, err := BoolWithProbability(0); err != nil || b {
+			t.Fatalf("p=0 got %v err %v", b, err)
+		}
+		if b, err := BoolWithProbability(1); err != nil || !b {
+			t.Fatalf("p=1 got %v err %v", b, err)
+		}
+	}
+	// 0.25 as a 53-bit fraction sits exactly on the p=0.25 threshold.
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Float64Bytes(0.25)))
+	if b, err := gen.BoolWithProbability(0.25); err != nil || b {
+		t.Fatalf("u=0.25 p=0.25 got %v err %v want false", b, err)
+	}
+	for _, p := range []float64{-0.1, 1.1, math.NaN()} {
+		_, err := BoolWithProbability(p)
+		var argErr *core.ArgError
+		if !errors.Is(err, core.ErrInvalidProbability) || !errors.As(err, &argErr) {
+			t.Fatalf("p=%v err=%v want ArgError wrapping ErrInvalidProbability", p, err)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	for i := 0; i < 500; i++ {
+		if v, err := Percent(); err != nil || v < 0 || v > 100 {
+			t.Fatalf("Percent value: %d err: %v", v, err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package numeric
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// maxDecimalUnits keeps scaled bounds within the exactly representable
+// integers of float64.
+const maxDecimalUnits = 1 << 53
+
+// maxDecimalPlaces is the largest places whose unit 10^places fits in an
+// int64; it also bounds the big.Int work in scaleDecimal.
+const maxDecimalPlaces = 18
+
+// Decimal returns a uniform random value in [minInclusive, maxInclusive]
+// rounded to places decimal places, such as a money amount with places 2.
+// Every multiple of 10^-places in the range is equally likely.
+//
+// Parameters:
+//   - minInclusive: The minimum value (inclusive).
+//   - maxInclusive: The maximum value (inclusive).
+//   - places: The number of decimal places, in [0, 18].
+//
+// Returns:
+//   - float64: The nearest float64 to the chosen decimal.
+//   - error: An error if the bounds are invalid, no multiple of 10^-places
+//     lies in the range, places is outside [0, 18], the scaled range exceeds
+//     2^53, or if crypto/rand fails.
+func Decimal(minInclusive float64, maxInclusive float64, places int) (float64, error) {
+	return Default().Decimal(minInclusive, maxInclusive, places)
+}
+
+// DecimalString is like Decimal but returns the exact decimal text, for
+// example "12.30", avoiding float formatting artifacts.
+//
+// Parameters:
+//   - minInclusive: The minimum value (inclusive).
+//   - maxInclusive: The maximum value (inclusive).
+//   - places: The number of decimal places, in [0, 18].
+//
+// Returns:
+//   - string: The chosen decimal with exactly places fractional digits.
+//   - error: An error under the same conditions as Decimal.
+func DecimalString(minInclusive float64, maxInclusive float64, places int) (string, error) {
+	return Default().DecimalString(minInclusive, maxInclusive, places)
+}
+
+// Decimal returns a random value in [minInclusive, maxInclusive] rounded to
+// places decimal places using the generator.
+func (g *Generator) Decimal(minInclusive float64, maxInclusive float64, places int) (float64, error) {
+	units, err := g.decimalUnits("Decimal", minInclusive, maxInclusive, places)
+	if err != nil {
+		return 0, err
+	}
+	return float64(units) / math.Pow10(places), nil
+}
+
+// DecimalString returns the exact decimal text of a random value in
+// [minInclusive, maxInclusive] with places decimal places using the
+// generator.
+func (g *Generator) DecimalString(minInclusive float64, maxInclusive float64, places int) (string, error) {
+	units, err := g.decimalUnits("DecimalString", minInclusive, maxInclusive, places)
+	if err != nil {
+		return "", err
+	}
+	return formatUnits(units, places), nil
+}
+
+// decimalUnits draws an integer number of 10^-places units whose value lies
+// in [minInclusive, maxInclusive].
+func (g *Generator) decimalUnits(op string, minInclusive float64, maxInclusive float64, places int) (int64, error) {
+	if places < 0 {
+		return 0, &core.ArgError{Op: op, Arg: "places", Value: places, Err: core.ErrNegativeLength}
+	}
+	if places > maxDecimalPlaces {
+		return 0, &core.ArgError{Op: op, Arg: "places", Value: places, Err: core.ErrResultOutOfRange}
+	}
+	if math.IsNaN(minInclusive) || math.IsNaN(maxInclusive) ||
+		math.IsInf(minInclusive, 0) || math.IsInf(maxInclusive, 0) {
+		return 0, &core.RangeError{Op: op, Min: minInclusive, Max: maxInclusive, Err: core.ErrNonFiniteBound}
+	}
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: op, Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	lo, okLo := scaleDecimal(minInclusive, places, true)
+	hi, okHi := scaleDecimal(maxInclusive, places, false)
+	if !okLo || !okHi {
+		return 0, &core.RangeError{Op: op, Min: minInclusive, Max: maxInclusive, Err: core.ErrResultOutOfRange}
+	}
+	if lo > hi {
+		return 0, &core.RangeError{Op: op, Min: minInclusive, Max: maxInclusive, Err: core.ErrInvalidRangeNonPositive}
+	}
+	return g.rng.Int64Range(lo, hi)
+}
+
+// scaleDecimal returns x*10^places rounded up (or down) to an integer. x is
+// taken at its shortest decimal representation, so 0.07 scales to exactly 7
+// rather than to the binary value 7.000000000000001. ok is false if the
+// result exceeds maxDecimalUnits in magnitude.
+func scaleDecimal(x float64, places int, up bool) (v int64, ok bool) {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(x, 'f', -1, 64))
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	r.Mul(r, new(big.Rat).SetInt(pow))
+	q, m := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	// DivMod floors for a positive denominator.
+	if up && m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if q.CmpAbs(big.NewInt(maxDecimalUnits)) > 0 {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+// formatUnits renders units*10^-places with exactly places fractional digits.
+func formatUnits(units int64, places int) string {
+	neg := units < 0
+	digits := strconv.FormatUint(absInt64(units), 10)
+	if places > 0 {
+		if len(digits) <= places {
+			digits = strings.Repeat("0", places-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		// #nosec G115 -- two's complement negation handles math.MinInt64.
+		return uint64(^v) + 1
+	}
+	// #nosec G115 -- v is non-negative.
+	return uint64(v)
+}
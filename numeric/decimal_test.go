@@ -0,0 +1,90 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestDecimalRounding(t *testing.T) {
+	for i := 0; i < 500; i++ {
+		v, err := Decimal(1.5, 99.99, 2)
+		if err != nil || v < 1.5 || v > 99.99 {
+			t.Fatalf("Decimal value: %v err: %v", v, err)
+		}
+		if r := math.Round(v*100) / 100; r != v {
+			t.Fatalf("Decimal value %v not rounded to 2 places", v)
+		}
+	}
+}
+
+func TestDecimalStringExact(t *testing.T) {
+	for i := 0; i < 500; i++ {
+		s, err := DecimalString(-10, 10, 3)
+		if err != nil {
+			t.Fatalf("DecimalString error: %v", err)
+		}
+		dot := strings.IndexByte(s, '.')
+		if dot < 0 || len(s)-dot-1 != 3 {
+			t.Fatalf("DecimalString %q lacks 3 decimal places", s)
+		}
+		if v, err := strconv.ParseFloat(s, 64); err != nil || v < -10 || v > 10 {
+			t.Fatalf("DecimalString %q out of range: %v", s, err)
+		}
+	}
+	// The first unit of [0.05, 1] at 2 places is 5, rendered with a leading 0.
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if s, err := gen.DecimalString(0.05, 1, 2); err != nil || s != "0.05" {
+		t.Fatalf("DecimalString = %q err: %v want 0.05", s, err)
+	}
+	if s := formatUnits(-7, 3); s != "-0.007" {
+		t.Fatalf("formatUnits(-7, 3) = %q want -0.007", s)
+	}
+	if s := formatUnits(42, 0); s != "42" {
+		t.Fatalf("formatUnits(42, 0) = %q want 42", s)
+	}
+}
+
+func TestDecimalErrors(t *testing.T) {
+	cases := []struct {
+		lo, hi float64
+		places int
+		want   error
+	}{
+		{0, 1, -1, core.ErrNegativeLength},
+		{math.NaN(), 1, 2, core.ErrNonFiniteBound},
+		{2, 1, 2, core.ErrMinGreaterThanMax},
+		{0.001, 0.002, 2, core.ErrInvalidRangeNonPositive},
+		{0, 1e10, 10, core.ErrResultOutOfRange},
+		{0, 1, 19, core.ErrResultOutOfRange},
+		{0, 1, 50_000_000, core.ErrResultOutOfRange},
+	}
+	for _, tc := range cases {
+		if _, err := Decimal(tc.lo, tc.hi, tc.places); !errors.Is(err, tc.want) {
+			t.Fatalf("Decimal(%v, %v, %d) err=%v want %v", tc.lo, tc.hi, tc.places, err, tc.want)
+		}
+	}
+	var argErr *core.ArgError
+	if _, err := DecimalString(0, 1, 19); !errors.As(err, &argErr) || argErr.Arg != "places" {
+		t.Fatalf("DecimalString places=19 err=%v want places ArgError", err)
+	}
+	if _, err := Decimal(0, 0, maxDecimalPlaces); err != nil {
+		t.Fatalf("Decimal places=%d error: %v", maxDecimalPlaces, err)
+	}
+}
+
+func TestDecimalUsesShortestBounds(t *testing.T) {
+	// 0.07*100 is 7.000000000000001 in binary; the bound must still be 7.
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if s, err := gen.DecimalString(0.07, 0.07, 2); err != nil || s != "0.07" {
+		t.Fatalf("DecimalString = %q err: %v want 0.07", s, err)
+	}
+	if v, err := Decimal(-0.29, -0.29, 2); err != nil || v != -0.29 {
+		t.Fatalf("Decimal = %v err: %v want -0.29", v, err)
+	}
+}
@@ -0,0 +1,62 @@
+package numeric
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Interval selects which endpoints a float range includes. It is
+// core.Interval, so values can be passed to either package.
+type Interval = core.Interval
+
+const (
+	// HalfOpen is [lower, upper).
+	HalfOpen = core.HalfOpen
+	// Closed is [lower, upper].
+	Closed = core.Closed
+	// Open is (lower, upper).
+	Open = core.Open
+	// OpenClosed is (lower, upper].
+	OpenClosed = core.OpenClosed
+)
+
+// Float64Range returns a uniform random float64 between lower and upper with
+// the endpoints selected by interval.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float64: A random float64 in the requested interval.
+//   - error: An error if a bound is not finite, the interval is empty, or if
+//     crypto/rand fails.
+func Float64Range(lower float64, upper float64, interval Interval) (float64, error) {
+	return Default().Float64Range(lower, upper, interval)
+}
+
+// Float32Range returns a uniform random float32 between lower and upper with
+// the endpoints selected by interval.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float32: A random float32 in the requested interval.
+//   - error: An error if a bound is not finite, the interval is empty, or if
+//     crypto/rand fails.
+func Float32Range(lower float32, upper float32, interval Interval) (float32, error) {
+	return Default().Float32Range(lower, upper, interval)
+}
+
+// Float64Range returns a uniform random float64 between lower and upper with
+// the endpoints selected by interval, using core.Generator.Float64Interval.
+func (g *Generator) Float64Range(lower float64, upper float64, interval Interval) (float64, error) {
+	return g.core().Float64Interval(lower, upper, interval)
+}
+
+// Float32Range returns a uniform random float32 between lower and upper with
+// the endpoints selected by interval, using core.Generator.Float32Interval.
+func (g *Generator) Float32Range(lower float32, upper float32, interval Interval) (float32, error) {
+	return g.core().Float32Interval(lower, upper, interval)
+}
@@ -0,0 +1,60 @@
+//go:build randutil_must
+// +build randutil_must
+
+package numeric
+
+// MustFloat64Range returns a secure random float64 between lower and upper
+// with the endpoints selected by interval. It panics if an error occurs.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float64: A random float64 in the requested interval.
+func MustFloat64Range(lower float64, upper float64, interval Interval) float64 {
+	v, err := Float64Range(lower, upper, interval)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustFloat32Range returns a secure random float32 between lower and upper
+// with the endpoints selected by interval. It panics if an error occurs.
+//
+// Parameters:
+//   - lower: The lower bound.
+//   - upper: The upper bound.
+//   - interval: Which endpoints may be returned.
+//
+// Returns:
+//   - float32: A random float32 in the requested interval.
+func MustFloat32Range(lower float32, upper float32, interval Interval) float32 {
+	v, err := Float32Range(lower, upper, interval)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustFloat64Range returns a secure random float64 in the requested interval.
+// It panics on error.
+func (g *Generator) MustFloat64Range(lower float64, upper float64, interval Interval) float64 {
+	v, err := g.Float64Range(lower, upper, interval)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustFloat32Range returns a secure random float32 in the requested interval.
+// It panics on error.
+func (g *Generator) MustFloat32Range(lower float32, upper float32, interval Interval) float32 {
+	v, err := g.Float32Range(lower, upper, interval)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,66 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestFloat64RangeHugeSpan(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		v, err := Float64Range(-math.MaxFloat64, math.MaxFloat64, Closed)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			t.Fatalf("Float64Range value: %v err: %v", v, err)
+		}
+	}
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(1 << 63)))
+	if v, err := gen.Float64Range(-math.MaxFloat64, math.MaxFloat64, HalfOpen); err != nil || v != 0 {
+		t.Fatalf("midpoint value: %v err: %v want 0", v, err)
+	}
+}
+
+func TestFloat32Range(t *testing.T) {
+	for _, iv := range []Interval{HalfOpen, Closed, Open, OpenClosed} {
+		for i := 0; i < 200; i++ {
+			v, err := Float32Range(1, 2, iv)
+			if err != nil || v < 1 || v > 2 {
+				t.Fatalf("interval %d: value %v err %v", iv, v, err)
+			}
+		}
+	}
+	if v, err := Float32Range(3, 3, Closed); err != nil || v != 3 {
+		t.Fatalf("degenerate closed value: %v err: %v", v, err)
+	}
+	next := math.Nextafter32(1, 2)
+	if _, err := Float32Range(1, next, Open); !errors.Is(err, core.ErrInvalidRangeNonPositive) {
+		t.Fatalf("adjacent open err=%v want ErrInvalidRangeNonPositive", err)
+	}
+}
+
+func TestFloat64RangeErrors(t *testing.T) {
+	cases := []struct {
+		lower, upper float64
+		interval     Interval
+		want         error
+	}{
+		{math.NaN(), 1, HalfOpen, core.ErrNonFiniteBound},
+		{0, math.Inf(1), Closed, core.ErrNonFiniteBound},
+		{2, 1, Closed, core.ErrMinGreaterThanMax},
+		{1, 1, HalfOpen, core.ErrInvalidRangeNonPositive},
+		{1, math.Nextafter(1, 2), Open, core.ErrInvalidRangeNonPositive},
+		{0, 1, Interval(9), core.ErrInvalidInterval},
+	}
+	for _, tc := range cases {
+		_, err := Float64Range(tc.lower, tc.upper, tc.interval)
+		var rangeErr *core.RangeError
+		if !errors.Is(err, tc.want) || !errors.As(err, &rangeErr) {
+			t.Fatalf("Float64Range(%v, %v, %d) err=%v want %v", tc.lower, tc.upper, tc.interval, err, tc.want)
+		}
+	}
+	if v, err := Float64Range(5, 5, Closed); err != nil || v != 5 {
+		t.Fatalf("degenerate closed value: %v err: %v", v, err)
+	}
+}
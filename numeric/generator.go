@@ -21,3 +21,30 @@ func New(rng rng) *Generator {
 func NewWithSource(src core.Source) *Generator {
 	return New(core.New(src))
 }
+
+// NewFromRNG returns a numeric Generator backed by rng, such as one returned
+// by adapters.LockedRNG or a generator over an instrumented source. It is
+// equivalent to New but names core.RNG in its signature so the accepted type
+// is documented. If rng is nil, crypto/rand is used.
+func NewFromRNG(rng core.RNG) *Generator {
+	return New(rng)
+}
+
+// core returns a core.Generator drawing from g.rng, so samplers that live
+// only on core.Generator are reused instead of copied here.
+func (g *Generator) core() *core.Generator {
+	if c, ok := g.rng.(*core.Generator); ok {
+		return c
+	}
+	return core.New(rngSource{g.rng})
+}
+
+// rngSource adapts an rng to core.Source through Fill.
+type rngSource struct{ rng rng }
+
+func (s rngSource) Read(p []byte) (int, error) {
+	if err := s.rng.Fill(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,61 @@
+package numeric
+
+import "github.com/aatuh/randutil/v2/core"
+
+// Integer is the set of integer types accepted by RangeOf. It matches
+// golang.org/x/exp/constraints.Integer without the dependency.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// RangeOf returns a secure random T in [minInclusive, maxInclusive] for any
+// signed or unsigned integer type, so callers need not convert between widths
+// or pick among IntRange, Int32Range, Uint64Range and friends.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - T: A random T in [minInclusive, maxInclusive].
+//   - error: An error if minInclusive > maxInclusive or if crypto/rand fails.
+func RangeOf[T Integer](minInclusive T, maxInclusive T) (T, error) {
+	return RangeOfWith(Default(), minInclusive, maxInclusive)
+}
+
+// RangeOfWith is like RangeOf but draws from g. Go does not allow generic
+// methods, so the generator is passed explicitly.
+//
+// Parameters:
+// - g: The generator to draw from.
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - T: A random T in [minInclusive, maxInclusive].
+//   - error: An error if minInclusive > maxInclusive or if entropy fails.
+func RangeOfWith[T Integer](g *Generator, minInclusive T, maxInclusive T) (T, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "RangeOf", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	var zero T
+	if ^zero < zero {
+		// Signed types all fit in int64.
+		// #nosec G115 -- T is signed here, so the bounds fit in int64.
+		v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+		// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+		return T(v), err
+	}
+	// Unsigned types all fit in uint64; a zero span means the full range.
+	// #nosec G115 -- T is unsigned here, so the bounds fit in uint64.
+	lo := uint64(minInclusive)
+	span := uint64(maxInclusive) - lo + 1
+	if span == 0 {
+		u, err := g.rng.Uint64()
+		return T(u), err
+	}
+	u, err := g.rng.Uint64n(span)
+	// #nosec G115 -- lo+u lies in [minInclusive, maxInclusive].
+	return T(lo + u), err
+}
@@ -0,0 +1,52 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type celsius int16
+
+func TestRangeOfBounds(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		if v, err := RangeOf[int8](-3, 3); err != nil || v < -3 || v > 3 {
+			t.Fatalf("RangeOf[int8] value: %d err: %v", v, err)
+		}
+		if v, err := RangeOf[uint](10, 12); err != nil || v < 10 || v > 12 {
+			t.Fatalf("RangeOf[uint] value: %d err: %v", v, err)
+		}
+		if v, err := RangeOf[celsius](-40, 40); err != nil || v < -40 || v > 40 {
+			t.Fatalf("RangeOf[celsius] value: %d err: %v", v, err)
+		}
+	}
+	if v, err := RangeOf[uint64](math.MaxUint64, math.MaxUint64); err != nil || v != math.MaxUint64 {
+		t.Fatalf("RangeOf[uint64] value: %d err: %v", v, err)
+	}
+}
+
+func TestRangeOfWithFullRanges(t *testing.T) {
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(math.MaxUint64)))
+	if v, err := RangeOfWith[uint64](gen, 0, math.MaxUint64); err != nil || v != math.MaxUint64 {
+		t.Fatalf("RangeOfWith[uint64] value: %d err: %v", v, err)
+	}
+	gen = NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if v, err := RangeOfWith[int64](gen, math.MinInt64, math.MaxInt64); err != nil || v != math.MinInt64 {
+		t.Fatalf("RangeOfWith[int64] value: %d err: %v", v, err)
+	}
+	gen = NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if v, err := RangeOfWith[int16](gen, math.MinInt16, math.MaxInt16); err != nil || v != math.MinInt16 {
+		t.Fatalf("RangeOfWith[int16] value: %d err: %v", v, err)
+	}
+}
+
+func TestRangeOfErrors(t *testing.T) {
+	_, err := RangeOf[uint32](5, 4)
+	var rangeErr *core.RangeError
+	if !errors.Is(err, core.ErrMinGreaterThanMax) || !errors.As(err, &rangeErr) || rangeErr.Op != "RangeOf" {
+		t.Fatalf("RangeOf err=%v want RangeError wrapping ErrMinGreaterThanMax", err)
+	}
+}
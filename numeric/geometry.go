@@ -0,0 +1,92 @@
+package numeric
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Complex128 returns a complex number distributed uniformly over the closed
+// disc of radius r centred at the origin.
+//
+// Parameters:
+//   - r: The disc radius; must be finite and > 0.
+//
+// Returns:
+//   - complex128: A random point in the disc.
+//   - error: An error if r is not finite and positive, or if crypto/rand
+//     fails.
+func Complex128(r float64) (complex128, error) { return Default().Complex128(r) }
+
+// UnitVector returns a vector distributed uniformly on the unit sphere in dim
+// dimensions (the circle for dim 2, the sphere for dim 3).
+//
+// Parameters:
+//   - dim: The number of dimensions; must be > 0.
+//
+// Returns:
+//   - []float64: A random vector of Euclidean length 1.
+//   - error: An error if dim <= 0 or if crypto/rand fails.
+func UnitVector(dim int) ([]float64, error) { return Default().UnitVector(dim) }
+
+// Complex128 returns a complex number uniform over the disc of radius r using
+// the generator. The radius is drawn as r*sqrt(u) so that area, not radius,
+// is uniform.
+func (g *Generator) Complex128(r float64) (complex128, error) {
+	if !(r > 0) || math.IsInf(r, 0) {
+		return 0, &core.ArgError{Op: "Complex128", Arg: "r", Value: r, Err: core.ErrNonPositiveBound}
+	}
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	v, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	rho := r * math.Sqrt(u)
+	sin, cos := math.Sincos(2 * math.Pi * v)
+	return complex(rho*cos, rho*sin), nil
+}
+
+// UnitVector returns a vector uniform on the unit sphere in dim dimensions
+// using the generator. It normalizes a vector of independent standard normal
+// variates, which is rotationally symmetric.
+func (g *Generator) UnitVector(dim int) ([]float64, error) {
+	if dim <= 0 {
+		return nil, &core.ArgError{Op: "UnitVector", Arg: "dim", Value: dim, Err: core.ErrNonPositiveBound}
+	}
+	out := make([]float64, dim)
+	for {
+		var norm float64
+		for i := range out {
+			z, err := g.stdNormal()
+			if err != nil {
+				return nil, err
+			}
+			out[i] = z
+			norm += z * z
+		}
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for i := range out {
+				out[i] /= norm
+			}
+			return out, nil
+		}
+	}
+}
+
+// stdNormal returns a standard normal variate using Box-Muller.
+func (g *Generator) stdNormal() (float64, error) {
+	u, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	v, err := g.rng.Float64()
+	if err != nil {
+		return 0, err
+	}
+	// 1-u lies in (0, 1], keeping the logarithm finite.
+	return math.Sqrt(-2*math.Log(1-u)) * math.Cos(2*math.Pi*v), nil
+}
@@ -0,0 +1,24 @@
+//go:build randutil_must
+// +build randutil_must
+
+package numeric
+
+// MustComplex128 returns a complex number uniform over the disc of radius r.
+// It panics if an error occurs.
+func MustComplex128(r float64) complex128 {
+	z, err := Complex128(r)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// MustUnitVector returns a vector uniform on the unit sphere in dim
+// dimensions. It panics if an error occurs.
+func MustUnitVector(dim int) []float64 {
+	v, err := UnitVector(dim)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
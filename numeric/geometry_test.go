@@ -0,0 +1,52 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestComplex128InDisc(t *testing.T) {
+	inner := 0
+	const n = 2000
+	for i := 0; i < n; i++ {
+		z, err := Complex128(2)
+		if err != nil || cmplx.Abs(z) > 2 {
+			t.Fatalf("Complex128 value: %v err: %v", z, err)
+		}
+		if cmplx.Abs(z) <= 1 {
+			inner++
+		}
+	}
+	// The inner half-radius disc holds a quarter of the area.
+	if inner < n/8 || inner > n*3/8 {
+		t.Fatalf("inner disc held %d of %d samples, want about %d", inner, n, n/4)
+	}
+	for _, r := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		if _, err := Complex128(r); !errors.Is(err, core.ErrNonPositiveBound) {
+			t.Fatalf("r=%v err=%v want ErrNonPositiveBound", r, err)
+		}
+	}
+}
+
+func TestUnitVector(t *testing.T) {
+	for _, dim := range []int{1, 2, 3, 10} {
+		v, err := UnitVector(dim)
+		if err != nil || len(v) != dim {
+			t.Fatalf("UnitVector(%d) = %v err: %v", dim, v, err)
+		}
+		var norm float64
+		for _, x := range v {
+			norm += x * x
+		}
+		if math.Abs(norm-1) > 1e-9 {
+			t.Fatalf("UnitVector(%d) norm^2=%v want 1", dim, norm)
+		}
+	}
+	if _, err := UnitVector(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("UnitVector(0) err=%v want ErrNonPositiveBound", err)
+	}
+}
@@ -0,0 +1,49 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestIntNormalBoundsAndCentre(t *testing.T) {
+	const n = 2000
+	sum := 0
+	for i := 0; i < n; i++ {
+		v, err := IntNormal(40, 12, 18, 90)
+		if err != nil || v < 18 || v > 90 {
+			t.Fatalf("IntNormal value: %d err: %v", v, err)
+		}
+		sum += v
+	}
+	if mean := float64(sum) / n; math.Abs(mean-40) > 2 {
+		t.Fatalf("IntNormal mean=%v want about 40", mean)
+	}
+}
+
+func TestIntNormalDegenerateAndTail(t *testing.T) {
+	if v, err := IntNormal(7.4, 0, 0, 10); err != nil || v != 7 {
+		t.Fatalf("stddev 0 = %d err: %v want 7", v, err)
+	}
+	if v, err := IntNormal(1000, 0, 0, 10); err != nil || v != 10 {
+		t.Fatalf("clamped mean = %d err: %v want 10", v, err)
+	}
+	// Bounds far in the tail fall back to clamping instead of looping.
+	if v, err := IntNormal(0, 1, 100, 200); err != nil || v != 100 {
+		t.Fatalf("tail bounds = %d err: %v want 100", v, err)
+	}
+}
+
+func TestIntNormalErrors(t *testing.T) {
+	if _, err := IntNormal(math.NaN(), 1, 0, 1); !errors.Is(err, core.ErrNonFiniteBound) {
+		t.Fatalf("NaN mean err=%v want ErrNonFiniteBound", err)
+	}
+	if _, err := IntNormal(0, -1, 0, 1); !errors.Is(err, core.ErrNegativeStdDev) {
+		t.Fatalf("negative stddev err=%v want ErrNegativeStdDev", err)
+	}
+	if _, err := IntNormal(0, 1, 2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("inverted bounds err=%v want ErrMinGreaterThanMax", err)
+	}
+}
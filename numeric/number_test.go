@@ -5,6 +5,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/aatuh/randutil/v2/adapters"
 	"github.com/aatuh/randutil/v2/core"
 	"github.com/aatuh/randutil/v2/internal/testutil"
 )
@@ -99,3 +100,14 @@ func TestFloat64Deterministic(t *testing.T) {
 		t.Fatalf("Float64 = %f want 0.25", v)
 	}
 }
+
+func TestNewFromRNG(t *testing.T) {
+	rng := adapters.LockedRNG(core.New(testutil.NewSeqReader(testutil.Uint64Bytes(5))))
+	gen := NewFromRNG(rng)
+	if v, err := gen.Uint64(); err != nil || v != 5 {
+		t.Fatalf("Uint64 = %d err: %v want 5", v, err)
+	}
+	if _, err := NewFromRNG(nil).Uint64(); err != nil {
+		t.Fatalf("nil rng Uint64 error: %v", err)
+	}
+}
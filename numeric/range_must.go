@@ -116,3 +116,20 @@ func MustPositiveInt64() int64 {
 func MustNegativeInt64() int64 {
 	return MustInt64Range(minInt64, -1)
 }
+
+// MustRangeOf returns a secure random T in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - T: A random T in [minInclusive, maxInclusive].
+func MustRangeOf[T Integer](minInclusive T, maxInclusive T) T {
+	v, err := RangeOf(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
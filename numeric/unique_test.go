@@ -0,0 +1,57 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestUniqueIntRangeDistinct(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		got, err := UniqueIntRange(-5, 14, 12)
+		if err != nil || len(got) != 12 {
+			t.Fatalf("UniqueIntRange = %v err: %v", got, err)
+		}
+		seen := map[int]bool{}
+		for _, v := range got {
+			if v < -5 || v > 14 || seen[v] {
+				t.Fatalf("UniqueIntRange value %d out of range or repeated in %v", v, got)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestUniqueIntRangeFullAndEmpty(t *testing.T) {
+	got, err := UniqueIntRange(1, 10, 10)
+	if err != nil {
+		t.Fatalf("UniqueIntRange error: %v", err)
+	}
+	sort.Ints(got)
+	for i, v := range got {
+		if v != i+1 {
+			t.Fatalf("full range = %v want 1..10", got)
+		}
+	}
+	if got, err := UniqueIntRange(1, 10, 0); err != nil || len(got) != 0 {
+		t.Fatalf("count 0 = %v err: %v", got, err)
+	}
+	if got, err := UniqueIntRange(math.MinInt, math.MaxInt, 3); err != nil || len(got) != 3 {
+		t.Fatalf("whole int range = %v err: %v", got, err)
+	}
+}
+
+func TestUniqueIntRangeErrors(t *testing.T) {
+	if _, err := UniqueIntRange(1, 3, 4); !errors.Is(err, core.ErrSampleTooLarge) {
+		t.Fatalf("too many err=%v want ErrSampleTooLarge", err)
+	}
+	if _, err := UniqueIntRange(1, 3, -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative count err=%v want ErrNegativeLength", err)
+	}
+	if _, err := UniqueIntRange(3, 1, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("inverted range err=%v want ErrMinGreaterThanMax", err)
+	}
+}
@@ -0,0 +1,135 @@
+package numeric
+
+import "math"
+
+// Int8Range returns a secure random int8 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - int8: A random int8 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Int8Range(minInclusive int8, maxInclusive int8) (int8, error) {
+	return Default().Int8Range(minInclusive, maxInclusive)
+}
+
+// AnyInt8 returns a secure random int8 in the full int8 range.
+//
+// Returns:
+//   - int8: A random int8 in [-128, 127].
+//   - error: An error if crypto/rand fails.
+func AnyInt8() (int8, error) {
+	return Int8Range(math.MinInt8, math.MaxInt8)
+}
+
+// Int16Range returns a secure random int16 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - int16: A random int16 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Int16Range(minInclusive int16, maxInclusive int16) (int16, error) {
+	return Default().Int16Range(minInclusive, maxInclusive)
+}
+
+// AnyInt16 returns a secure random int16 in the full int16 range.
+//
+// Returns:
+//   - int16: A random int16 in [-32768, 32767].
+//   - error: An error if crypto/rand fails.
+func AnyInt16() (int16, error) {
+	return Int16Range(math.MinInt16, math.MaxInt16)
+}
+
+// Uint8Range returns a secure random uint8 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint8: A random uint8 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Uint8Range(minInclusive uint8, maxInclusive uint8) (uint8, error) {
+	return Default().Uint8Range(minInclusive, maxInclusive)
+}
+
+// AnyUint8 returns a secure random uint8 in the full uint8 range.
+//
+// Returns:
+//   - uint8: A random uint8 in [0, 255].
+//   - error: An error if crypto/rand fails.
+func AnyUint8() (uint8, error) {
+	return Uint8Range(0, math.MaxUint8)
+}
+
+// Uint16Range returns a secure random uint16 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint16: A random uint16 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Uint16Range(minInclusive uint16, maxInclusive uint16) (uint16, error) {
+	return Default().Uint16Range(minInclusive, maxInclusive)
+}
+
+// AnyUint16 returns a secure random uint16 in the full uint16 range.
+//
+// Returns:
+//   - uint16: A random uint16 in [0, 65535].
+//   - error: An error if crypto/rand fails.
+func AnyUint16() (uint16, error) {
+	return Uint16Range(0, math.MaxUint16)
+}
+
+// Uint32Range returns a secure random uint32 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint32: A random uint32 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Uint32Range(minInclusive uint32, maxInclusive uint32) (uint32, error) {
+	return Default().Uint32Range(minInclusive, maxInclusive)
+}
+
+// AnyUint32 returns a secure random uint32 in the full uint32 range.
+//
+// Returns:
+//   - uint32: A random uint32 in [0, 4294967295].
+//   - error: An error if crypto/rand fails.
+func AnyUint32() (uint32, error) {
+	return Uint32Range(0, math.MaxUint32)
+}
+
+// Uint64Range returns a secure random uint64 in [minInclusive, maxInclusive].
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint64: A random uint64 in [minInclusive, maxInclusive].
+//   - error: An error if crypto/rand fails.
+func Uint64Range(minInclusive uint64, maxInclusive uint64) (uint64, error) {
+	return Default().Uint64Range(minInclusive, maxInclusive)
+}
+
+// AnyUint64 returns a secure random uint64 in the full uint64 range.
+//
+// Returns:
+//   - uint64: A random uint64 in [0, 18446744073709551615].
+//   - error: An error if crypto/rand fails.
+func AnyUint64() (uint64, error) {
+	return Uint64Range(0, math.MaxUint64)
+}
@@ -0,0 +1,109 @@
+package numeric
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Int8Range returns a secure random int8 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Int8Range(minInclusive int8, maxInclusive int8) (int8, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Int8Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+	// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+	return int8(v), err
+}
+
+// AnyInt8 returns a secure random int8 in the full int8 range.
+func (g *Generator) AnyInt8() (int8, error) {
+	return g.Int8Range(math.MinInt8, math.MaxInt8)
+}
+
+// Int16Range returns a secure random int16 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Int16Range(minInclusive int16, maxInclusive int16) (int16, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Int16Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+	// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+	return int16(v), err
+}
+
+// AnyInt16 returns a secure random int16 in the full int16 range.
+func (g *Generator) AnyInt16() (int16, error) {
+	return g.Int16Range(math.MinInt16, math.MaxInt16)
+}
+
+// Uint8Range returns a secure random uint8 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Uint8Range(minInclusive uint8, maxInclusive uint8) (uint8, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Uint8Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+	// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+	return uint8(v), err
+}
+
+// AnyUint8 returns a secure random uint8 in the full uint8 range.
+func (g *Generator) AnyUint8() (uint8, error) {
+	return g.Uint8Range(0, math.MaxUint8)
+}
+
+// Uint16Range returns a secure random uint16 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Uint16Range(minInclusive uint16, maxInclusive uint16) (uint16, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Uint16Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+	// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+	return uint16(v), err
+}
+
+// AnyUint16 returns a secure random uint16 in the full uint16 range.
+func (g *Generator) AnyUint16() (uint16, error) {
+	return g.Uint16Range(0, math.MaxUint16)
+}
+
+// Uint32Range returns a secure random uint32 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Uint32Range(minInclusive uint32, maxInclusive uint32) (uint32, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Uint32Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	v, err := g.rng.Int64Range(int64(minInclusive), int64(maxInclusive))
+	// #nosec G115 -- v lies in [minInclusive, maxInclusive].
+	return uint32(v), err
+}
+
+// AnyUint32 returns a secure random uint32 in the full uint32 range.
+func (g *Generator) AnyUint32() (uint32, error) {
+	return g.Uint32Range(0, math.MaxUint32)
+}
+
+// Uint64Range returns a secure random uint64 in [minInclusive, maxInclusive]
+// using the generator's entropy source.
+func (g *Generator) Uint64Range(minInclusive uint64, maxInclusive uint64) (uint64, error) {
+	if minInclusive > maxInclusive {
+		return 0, &core.RangeError{Op: "Uint64Range", Min: minInclusive, Max: maxInclusive, Err: core.ErrMinGreaterThanMax}
+	}
+	span := maxInclusive - minInclusive + 1
+	if span == 0 {
+		return g.rng.Uint64()
+	}
+	u, err := g.rng.Uint64n(span)
+	if err != nil {
+		return 0, err
+	}
+	return minInclusive + u, nil
+}
+
+// AnyUint64 returns a secure random uint64 in the full uint64 range.
+func (g *Generator) AnyUint64() (uint64, error) {
+	return g.Uint64Range(0, math.MaxUint64)
+}
@@ -0,0 +1,124 @@
+//go:build randutil_must
+// +build randutil_must
+
+package numeric
+
+// MustInt8Range returns a secure random int8 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustInt8Range(minInclusive int8, maxInclusive int8) int8 {
+	v, err := g.Int8Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyInt8 returns a secure random int8 in the full int8 range.
+// It panics on error.
+func (g *Generator) MustAnyInt8() int8 {
+	v, err := g.AnyInt8()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustInt16Range returns a secure random int16 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustInt16Range(minInclusive int16, maxInclusive int16) int16 {
+	v, err := g.Int16Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyInt16 returns a secure random int16 in the full int16 range.
+// It panics on error.
+func (g *Generator) MustAnyInt16() int16 {
+	v, err := g.AnyInt16()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustUint8Range returns a secure random uint8 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustUint8Range(minInclusive uint8, maxInclusive uint8) uint8 {
+	v, err := g.Uint8Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint8 returns a secure random uint8 in the full uint8 range.
+// It panics on error.
+func (g *Generator) MustAnyUint8() uint8 {
+	v, err := g.AnyUint8()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustUint16Range returns a secure random uint16 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustUint16Range(minInclusive uint16, maxInclusive uint16) uint16 {
+	v, err := g.Uint16Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint16 returns a secure random uint16 in the full uint16 range.
+// It panics on error.
+func (g *Generator) MustAnyUint16() uint16 {
+	v, err := g.AnyUint16()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustUint32Range returns a secure random uint32 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustUint32Range(minInclusive uint32, maxInclusive uint32) uint32 {
+	v, err := g.Uint32Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint32 returns a secure random uint32 in the full uint32 range.
+// It panics on error.
+func (g *Generator) MustAnyUint32() uint32 {
+	v, err := g.AnyUint32()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustUint64Range returns a secure random uint64 in [minInclusive, maxInclusive].
+// It panics on error.
+func (g *Generator) MustUint64Range(minInclusive uint64, maxInclusive uint64) uint64 {
+	v, err := g.Uint64Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint64 returns a secure random uint64 in the full uint64 range.
+// It panics on error.
+func (g *Generator) MustAnyUint64() uint64 {
+	v, err := g.AnyUint64()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,162 @@
+//go:build randutil_must
+// +build randutil_must
+
+package numeric
+
+import "math"
+
+// MustInt8Range returns a secure random int8 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - int8: A random int8 in [minInclusive, maxInclusive].
+func MustInt8Range(minInclusive int8, maxInclusive int8) int8 {
+	v, err := Int8Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyInt8 returns a secure random int8 in the full int8 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - int8: A random int8 in the full int8 range.
+func MustAnyInt8() int8 {
+	return MustInt8Range(math.MinInt8, math.MaxInt8)
+}
+
+// MustInt16Range returns a secure random int16 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - int16: A random int16 in [minInclusive, maxInclusive].
+func MustInt16Range(minInclusive int16, maxInclusive int16) int16 {
+	v, err := Int16Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyInt16 returns a secure random int16 in the full int16 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - int16: A random int16 in the full int16 range.
+func MustAnyInt16() int16 {
+	return MustInt16Range(math.MinInt16, math.MaxInt16)
+}
+
+// MustUint8Range returns a secure random uint8 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint8: A random uint8 in [minInclusive, maxInclusive].
+func MustUint8Range(minInclusive uint8, maxInclusive uint8) uint8 {
+	v, err := Uint8Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint8 returns a secure random uint8 in the full uint8 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - uint8: A random uint8 in the full uint8 range.
+func MustAnyUint8() uint8 {
+	return MustUint8Range(0, math.MaxUint8)
+}
+
+// MustUint16Range returns a secure random uint16 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint16: A random uint16 in [minInclusive, maxInclusive].
+func MustUint16Range(minInclusive uint16, maxInclusive uint16) uint16 {
+	v, err := Uint16Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint16 returns a secure random uint16 in the full uint16 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - uint16: A random uint16 in the full uint16 range.
+func MustAnyUint16() uint16 {
+	return MustUint16Range(0, math.MaxUint16)
+}
+
+// MustUint32Range returns a secure random uint32 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint32: A random uint32 in [minInclusive, maxInclusive].
+func MustUint32Range(minInclusive uint32, maxInclusive uint32) uint32 {
+	v, err := Uint32Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint32 returns a secure random uint32 in the full uint32 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - uint32: A random uint32 in the full uint32 range.
+func MustAnyUint32() uint32 {
+	return MustUint32Range(0, math.MaxUint32)
+}
+
+// MustUint64Range returns a secure random uint64 in [minInclusive, maxInclusive].
+// It panics if an error occurs.
+//
+// Parameters:
+// - minInclusive: The minimum value (inclusive).
+// - maxInclusive: The maximum value (inclusive).
+//
+// Returns:
+//   - uint64: A random uint64 in [minInclusive, maxInclusive].
+func MustUint64Range(minInclusive uint64, maxInclusive uint64) uint64 {
+	v, err := Uint64Range(minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustAnyUint64 returns a secure random uint64 in the full uint64 range.
+// It panics if an error occurs.
+//
+// Returns:
+//   - uint64: A random uint64 in the full uint64 range.
+func MustAnyUint64() uint64 {
+	return MustUint64Range(0, math.MaxUint64)
+}
@@ -0,0 +1,50 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestWidthRanges(t *testing.T) {
+	if v, err := Int8Range(-2, 2); err != nil || v < -2 || v > 2 {
+		t.Fatalf("Int8Range value: %d err: %v", v, err)
+	}
+	if v, err := Int16Range(100, 200); err != nil || v < 100 || v > 200 {
+		t.Fatalf("Int16Range value: %d err: %v", v, err)
+	}
+	if v, err := Uint8Range(1, 3); err != nil || v < 1 || v > 3 {
+		t.Fatalf("Uint8Range value: %d err: %v", v, err)
+	}
+	if v, err := Uint16Range(1000, 1001); err != nil || v < 1000 || v > 1001 {
+		t.Fatalf("Uint16Range value: %d err: %v", v, err)
+	}
+	if v, err := Uint32Range(7, 7); err != nil || v != 7 {
+		t.Fatalf("Uint32Range value: %d err: %v", v, err)
+	}
+	if v, err := Uint64Range(math.MaxUint64-1, math.MaxUint64); err != nil || v < math.MaxUint64-1 {
+		t.Fatalf("Uint64Range value: %d err: %v", v, err)
+	}
+	if _, err := AnyUint32(); err != nil {
+		t.Fatalf("AnyUint32 error: %v", err)
+	}
+}
+
+func TestWidthRangesMinimum(t *testing.T) {
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	if v, err := gen.AnyInt16(); err != nil || v != math.MinInt16 {
+		t.Fatalf("AnyInt16 = %d err: %v want %d", v, err, math.MinInt16)
+	}
+}
+
+func TestWidthRangesInvalid(t *testing.T) {
+	if _, err := Uint8Range(5, 4); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("Uint8Range err=%v want ErrMinGreaterThanMax", err)
+	}
+	if _, err := Uint64Range(5, 4); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("Uint64Range err=%v want ErrMinGreaterThanMax", err)
+	}
+}
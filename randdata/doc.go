@@ -0,0 +1,5 @@
+// Package randdata writes random binary data to streams and temporary files
+// in fixed-size chunks, with optional progress callbacks, for disk and
+// network throughput testing. Generators are concurrency-safe iff the
+// injected RNG is safe.
+package randdata
@@ -0,0 +1,14 @@
+package randdata
+
+import (
+	"fmt"
+	"io"
+)
+
+func ExampleStream() {
+	n, err := Stream(io.Discard, 1<<20, WithProgress(func(written, total int64) {
+		// Report throughput here.
+	}))
+	fmt.Println(n, err)
+	// Output: 1048576 <nil>
+}
@@ -0,0 +1,35 @@
+package randdata
+
+// DefaultChunkSize is the number of bytes generated and written per chunk
+// unless WithChunkSize is given.
+const DefaultChunkSize = 64 << 10
+
+// ProgressFunc is called after every chunk with the bytes written so far
+// and the total requested.
+type ProgressFunc func(written, total int64)
+
+type config struct {
+	chunkSize int
+	progress  ProgressFunc
+}
+
+// Option configures Stream and TempFile.
+type Option func(*config)
+
+// WithChunkSize sets the chunk size in bytes; it must be > 0.
+func WithChunkSize(n int) Option {
+	return func(c *config) { c.chunkSize = n }
+}
+
+// WithProgress registers fn to be called after each chunk is written.
+func WithProgress(fn ProgressFunc) Option {
+	return func(c *config) { c.progress = fn }
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{chunkSize: DefaultChunkSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
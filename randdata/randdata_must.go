@@ -0,0 +1,25 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randdata
+
+import "io"
+
+// MustStream writes n random bytes to w. It panics on error.
+func MustStream(w io.Writer, n int64, opts ...Option) int64 {
+	written, err := Stream(w, n, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return written
+}
+
+// MustTempFile creates a temporary file of size random bytes. It panics on
+// error.
+func MustTempFile(dir string, size int64, opts ...Option) string {
+	path, err := TempFile(dir, size, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
@@ -0,0 +1,119 @@
+package randdata
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestStreamProgress(t *testing.T) {
+	var buf bytes.Buffer
+	var calls []int64
+	n, err := Stream(&buf, 10000, WithChunkSize(4096), WithProgress(func(written, total int64) {
+		if total != 10000 {
+			t.Fatalf("total=%d", total)
+		}
+		calls = append(calls, written)
+	}))
+	if err != nil || n != 10000 || buf.Len() != 10000 {
+		t.Fatalf("Stream n=%d len=%d err=%v", n, buf.Len(), err)
+	}
+	want := []int64{4096, 8192, 10000}
+	if len(calls) != len(want) {
+		t.Fatalf("progress calls=%v want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("progress calls=%v want %v", calls, want)
+		}
+	}
+	if bytes.Equal(buf.Bytes()[:4096], buf.Bytes()[4096:8192]) {
+		t.Fatal("chunks repeat")
+	}
+}
+
+func TestStreamDeterministic(t *testing.T) {
+	data := []byte("abcdefghij")
+	g := New(core.New(testutil.NewSeqReader(data)))
+	var buf bytes.Buffer
+	if _, err := g.Stream(&buf, int64(len(data)), WithChunkSize(3)); err != nil {
+		t.Fatalf("Stream error: %v", err)
+	}
+	if buf.String() != string(data) {
+		t.Fatalf("Stream wrote %q want %q", buf.String(), data)
+	}
+}
+
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) { return len(p) / 2, nil }
+
+type failWriter struct{ err error }
+
+func (w failWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestStreamErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Stream(&buf, -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	if _, err := Stream(&buf, 1, WithChunkSize(0)); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+	if n, err := Stream(&buf, 0); err != nil || n != 0 {
+		t.Fatalf("Stream(0) n=%d err=%v", n, err)
+	}
+	if n, err := Stream(shortWriter{}, 10); !errors.Is(err, io.ErrShortWrite) || n != 5 {
+		t.Fatalf("short write n=%d err=%v", n, err)
+	}
+	errDisk := errors.New("disk full")
+	if _, err := Stream(failWriter{errDisk}, 10); !errors.Is(err, errDisk) {
+		t.Fatalf("err=%v want %v", err, errDisk)
+	}
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.Stream(&buf, 10); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
+
+func TestTempFile(t *testing.T) {
+	dir := t.TempDir()
+	var last int64
+	path, err := TempFile(dir, 100000, WithProgress(func(written, _ int64) { last = written }))
+	if err != nil {
+		t.Fatalf("TempFile error: %v", err)
+	}
+	if filepath.Dir(path) != dir {
+		t.Fatalf("TempFile path %q not in %q", path, dir)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Size() != 100000 || last != 100000 {
+		t.Fatalf("size=%d last=%d err=%v", info.Size(), last, err)
+	}
+}
+
+func TestTempFileCleansUpOnError(t *testing.T) {
+	dir := t.TempDir()
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.TempFile(dir, 10); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("leftover files %v err=%v", entries, err)
+	}
+	if _, err := TempFile(dir, -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	if _, err := TempFile(filepath.Join(dir, "missing"), 1); err == nil {
+		t.Fatal("expected error for missing directory")
+	}
+}
@@ -0,0 +1,5 @@
+package randdata
+
+type rng interface {
+	Fill(p []byte) error
+}
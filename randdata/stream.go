@@ -0,0 +1,98 @@
+package randdata
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Stream writes n random bytes to w from the default generator.
+//
+// Parameters:
+//   - w: The destination.
+//   - n: Number of bytes to write.
+//   - opts: WithChunkSize and WithProgress.
+//
+// Returns:
+//   - int64: Bytes actually written, which is n on success.
+//   - error: ErrNegativeLength if n < 0, ErrNonPositiveBound for a bad
+//     chunk size, or a write or RNG error.
+func Stream(w io.Writer, n int64, opts ...Option) (int64, error) {
+	return Default().Stream(w, n, opts...)
+}
+
+// Stream writes n random bytes to w, one chunk of memory at a time.
+func (g *Generator) Stream(w io.Writer, n int64, opts ...Option) (int64, error) {
+	if n < 0 {
+		return 0, &core.ArgError{Op: "Stream", Arg: "n", Value: n, Err: core.ErrNegativeLength}
+	}
+	cfg := newConfig(opts)
+	if cfg.chunkSize <= 0 {
+		return 0, &core.ArgError{Op: "Stream", Arg: "chunkSize", Value: cfg.chunkSize, Err: core.ErrNonPositiveBound}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	buf := make([]byte, min(n, int64(cfg.chunkSize)))
+	var written int64
+	for written < n {
+		chunk := buf[:min(n-written, int64(len(buf)))]
+		if err := g.rng.Fill(chunk); err != nil {
+			return written, err
+		}
+		m, err := w.Write(chunk)
+		written += int64(m)
+		if err != nil {
+			return written, err
+		}
+		if m < len(chunk) {
+			return written, io.ErrShortWrite
+		}
+		if cfg.progress != nil {
+			cfg.progress(written, n)
+		}
+	}
+	return written, nil
+}
+
+// TempFile creates a file in dir filled with size random bytes from the
+// default generator.
+//
+// Parameters:
+//   - dir: Directory for the file; "" means os.TempDir().
+//   - size: File size in bytes.
+//   - opts: WithChunkSize and WithProgress.
+//
+// Returns:
+//   - string: Path of the new file; the caller is responsible for removing
+//     it.
+//   - error: An argument, file system or RNG error. No file is left behind
+//     on error.
+func TempFile(dir string, size int64, opts ...Option) (string, error) {
+	return Default().TempFile(dir, size, opts...)
+}
+
+// TempFile creates a file in dir filled with size random bytes and returns
+// its path.
+func (g *Generator) TempFile(dir string, size int64, opts ...Option) (path string, err error) {
+	if size < 0 {
+		return "", &core.ArgError{Op: "TempFile", Arg: "size", Value: size, Err: core.ErrNegativeLength}
+	}
+	f, err := os.CreateTemp(dir, "randdata-*.bin")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(f.Name())
+		}
+	}()
+	_, err = g.Stream(f, size, opts...)
+	err = errors.Join(err, f.Close())
+	if err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
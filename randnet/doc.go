@@ -0,0 +1,4 @@
+// Package randnet generates random network identifiers: IP addresses,
+// addresses inside a CIDR prefix, MAC addresses, ports, host names and
+// URLs. Generators are concurrency-safe iff the injected RNG is safe.
+package randnet
@@ -0,0 +1,9 @@
+package randnet
+
+import "errors"
+
+// Package-level errors for network identifier generation.
+var (
+	ErrInvalidCIDR   = errors.New("randutil: invalid CIDR prefix")
+	ErrInvalidScheme = errors.New("randutil: invalid URL scheme")
+)
@@ -0,0 +1,13 @@
+package randnet
+
+import "fmt"
+
+func ExampleIPInCIDR() {
+	addr, err := IPInCIDR("10.20.0.0/16")
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	fmt.Println(addr.As4()[0], addr.As4()[1])
+	// Output: 10 20
+}
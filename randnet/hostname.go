@@ -0,0 +1,116 @@
+package randnet
+
+import "github.com/aatuh/randutil/v2/core"
+
+const (
+	maxHostnameLen = 253
+	// maxHostnameLabels is the most labels that fit in maxHostnameLen with
+	// one-character labels and a two-letter final label.
+	maxHostnameLabels = maxHostnameLen / 2
+
+	lowerLetters = "abcdefghijklmnopqrstuvwxyz"
+	lowerAlnum   = lowerLetters + "0123456789"
+)
+
+// Hostname returns a random DNS hostname from the default generator.
+//
+// Parameters:
+//   - labels: Number of dot-separated labels, in [1, 126]. With two or
+//     more labels the last one is an alphabetic TLD of 2-6 letters.
+//
+// Returns:
+//   - string: A hostname such as "k3xq-a.mzp"; every label is 1-63
+//     lowercase letters, digits or hyphens, starts with a letter and does
+//     not end with a hyphen, and the total length is at most 253.
+//   - error: ErrNonPositiveBound if labels < 1, ErrUnsatisfiable if labels
+//     cannot fit in 253 characters, or an RNG error.
+func Hostname(labels int) (string, error) {
+	return Default().Hostname(labels)
+}
+
+// Hostname returns a random DNS hostname with the given number of labels.
+func (g *Generator) Hostname(labels int) (string, error) {
+	if labels < 1 {
+		return "", &core.ArgError{Op: "Hostname", Arg: "labels", Value: labels, Err: core.ErrNonPositiveBound}
+	}
+	if labels > maxHostnameLabels {
+		return "", &core.ArgError{Op: "Hostname", Arg: "labels", Value: labels, Err: core.ErrUnsatisfiable}
+	}
+	slack := maxHostnameLen - minHostnameLen(labels)
+	return g.hostname(labels, &slack)
+}
+
+// minHostnameLen is the shortest hostname with the given label count.
+func minHostnameLen(labels int) int {
+	if labels == 1 {
+		return 1
+	}
+	return 2 * labels
+}
+
+// hostname builds a hostname whose labels grow beyond their minimum
+// length by at most *slack characters in total; *slack is decremented by
+// the characters used.
+func (g *Generator) hostname(labels int, slack *int) (string, error) {
+	buf := make([]byte, 0, minHostnameLen(labels)+*slack)
+	for i := 0; i < labels; i++ {
+		if i > 0 {
+			buf = append(buf, '.')
+		}
+		if labels > 1 && i == labels-1 {
+			n, err := g.length(2, 4, slack)
+			if err != nil {
+				return "", err
+			}
+			if buf, err = g.appendFrom(buf, lowerLetters, n); err != nil {
+				return "", err
+			}
+			continue
+		}
+		n, err := g.length(1, 9, slack)
+		if err != nil {
+			return "", err
+		}
+		if buf, err = g.appendLabel(buf, n); err != nil {
+			return "", err
+		}
+	}
+	return string(buf), nil
+}
+
+// appendLabel appends an n-character DNS label that starts with a letter
+// and does not end with a hyphen.
+func (g *Generator) appendLabel(buf []byte, n int) ([]byte, error) {
+	buf, err := g.appendFrom(buf, lowerLetters, 1)
+	if err != nil || n == 1 {
+		return buf, err
+	}
+	if buf, err = g.appendFrom(buf, lowerAlnum+"-", n-2); err != nil {
+		return nil, err
+	}
+	return g.appendFrom(buf, lowerAlnum, 1)
+}
+
+// length returns a length in [minLen, minLen+maxExtra], drawing the extra
+// characters from *slack.
+func (g *Generator) length(minLen, maxExtra int, slack *int) (int, error) {
+	maxExtra = min(maxExtra, *slack)
+	extra, err := g.rng.IntRange(0, maxExtra)
+	if err != nil {
+		return 0, err
+	}
+	*slack -= extra
+	return minLen + extra, nil
+}
+
+// appendFrom appends n bytes drawn uniformly from charset.
+func (g *Generator) appendFrom(buf []byte, charset string, n int) ([]byte, error) {
+	for i := 0; i < n; i++ {
+		j, err := g.rng.Uint64n(uint64(len(charset)))
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, charset[j])
+	}
+	return buf, nil
+}
@@ -0,0 +1,118 @@
+package randnet
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// privateIPv4Blocks are the RFC 1918 private ranges.
+var privateIPv4Blocks = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+}
+
+// IPv4 returns a uniformly random IPv4 address from the default generator.
+//
+// Returns:
+//   - netip.Addr: Any of the 2^32 IPv4 addresses.
+//   - error: An error if the RNG fails.
+func IPv4() (netip.Addr, error) {
+	return Default().IPv4()
+}
+
+// IPv6 returns a uniformly random IPv6 address from the default generator.
+//
+// Returns:
+//   - netip.Addr: Any of the 2^128 IPv6 addresses.
+//   - error: An error if the RNG fails.
+func IPv6() (netip.Addr, error) {
+	return Default().IPv6()
+}
+
+// IPInCIDR returns a uniformly random address inside cidr from the default
+// generator.
+//
+// Parameters:
+//   - cidr: An IPv4 or IPv6 prefix such as "10.1.0.0/16" or "2001:db8::/32".
+//
+// Returns:
+//   - netip.Addr: An address whose leading bits match the prefix.
+//   - error: ErrInvalidCIDR if cidr does not parse, or an RNG error.
+func IPInCIDR(cidr string) (netip.Addr, error) {
+	return Default().IPInCIDR(cidr)
+}
+
+// PrivateIPv4 returns a random RFC 1918 address from the default generator.
+//
+// Returns:
+//   - netip.Addr: An address in 10/8, 172.16/12 or 192.168/16.
+//   - error: An error if the RNG fails.
+func PrivateIPv4() (netip.Addr, error) {
+	return Default().PrivateIPv4()
+}
+
+// IPv4 returns a uniformly random IPv4 address.
+func (g *Generator) IPv4() (netip.Addr, error) {
+	var b [4]byte
+	if err := g.rng.Fill(b[:]); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom4(b), nil
+}
+
+// IPv6 returns a uniformly random IPv6 address.
+func (g *Generator) IPv6() (netip.Addr, error) {
+	var b [16]byte
+	if err := g.rng.Fill(b[:]); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom16(b), nil
+}
+
+// IPInCIDR returns a uniformly random address inside cidr. Host bits of
+// cidr are ignored, so "10.1.2.3/16" behaves like "10.1.0.0/16".
+func (g *Generator) IPInCIDR(cidr string) (netip.Addr, error) {
+	p, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%w: %v", ErrInvalidCIDR, err)
+	}
+	return g.IPInPrefix(p)
+}
+
+// IPInPrefix returns a uniformly random address inside p.
+func (g *Generator) IPInPrefix(p netip.Prefix) (netip.Addr, error) {
+	if !p.IsValid() {
+		return netip.Addr{}, ErrInvalidCIDR
+	}
+	p = p.Masked()
+	base := p.Addr().AsSlice()
+	random := make([]byte, len(base))
+	if err := g.rng.Fill(random); err != nil {
+		return netip.Addr{}, err
+	}
+	bits := p.Bits()
+	for i := range base {
+		// mask has 1s where the prefix fixes the bit.
+		var mask byte
+		switch {
+		case bits >= 8*(i+1):
+			mask = 0xff
+		case bits > 8*i:
+			mask = ^byte(0xff >> (bits - 8*i))
+		}
+		base[i] = base[i]&mask | random[i]&^mask
+	}
+	addr, _ := netip.AddrFromSlice(base)
+	return addr, nil
+}
+
+// PrivateIPv4 returns a random RFC 1918 address. The block is chosen
+// uniformly first, so 192.168/16 addresses are as common as 10/8 ones.
+func (g *Generator) PrivateIPv4() (netip.Addr, error) {
+	idx, err := g.rng.Uint64n(uint64(len(privateIPv4Blocks)))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return g.IPInPrefix(privateIPv4Blocks[idx])
+}
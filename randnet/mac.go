@@ -0,0 +1,73 @@
+package randnet
+
+import "net"
+
+// MAC address flag bits in the first octet.
+const (
+	macMulticast = 0x01
+	macLocal     = 0x02
+)
+
+type macConfig struct {
+	universal bool
+	multicast bool
+	oui       []byte
+}
+
+// MACOption configures MAC.
+type MACOption func(*macConfig)
+
+// WithUniversal clears the locally-administered bit, producing an address
+// that looks vendor-assigned. Such addresses may collide with real
+// hardware.
+func WithUniversal() MACOption {
+	return func(c *macConfig) { c.universal = true }
+}
+
+// WithMulticast sets the multicast (group) bit.
+func WithMulticast() MACOption {
+	return func(c *macConfig) { c.multicast = true }
+}
+
+// WithOUI fixes the first three octets to oui, leaving the flag bits
+// exactly as given; only the last three octets are random.
+func WithOUI(oui [3]byte) MACOption {
+	return func(c *macConfig) { c.oui = oui[:] }
+}
+
+// MAC returns a random 48-bit MAC address from the default generator.
+//
+// Parameters:
+//   - opts: Options; by default the address is unicast and locally
+//     administered, so it cannot clash with vendor-assigned hardware.
+//
+// Returns:
+//   - net.HardwareAddr: A 6-byte MAC address.
+//   - error: An error if the RNG fails.
+func MAC(opts ...MACOption) (net.HardwareAddr, error) {
+	return Default().MAC(opts...)
+}
+
+// MAC returns a random 48-bit MAC address.
+func (g *Generator) MAC(opts ...MACOption) (net.HardwareAddr, error) {
+	var cfg macConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	mac := make(net.HardwareAddr, 6)
+	if err := g.rng.Fill(mac); err != nil {
+		return nil, err
+	}
+	if cfg.oui != nil {
+		copy(mac, cfg.oui)
+		return mac, nil
+	}
+	mac[0] &^= macMulticast | macLocal
+	if !cfg.universal {
+		mac[0] |= macLocal
+	}
+	if cfg.multicast {
+		mac[0] |= macMulticast
+	}
+	return mac, nil
+}
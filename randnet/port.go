@@ -0,0 +1,42 @@
+package randnet
+
+import "github.com/aatuh/randutil/v2/core"
+
+// PortRange is an inclusive range of TCP/UDP port numbers.
+type PortRange struct {
+	Min uint16
+	Max uint16
+}
+
+// Common IANA port ranges.
+var (
+	AllPorts        = PortRange{Min: 1, Max: 65535}
+	WellKnownPorts  = PortRange{Min: 1, Max: 1023}
+	RegisteredPorts = PortRange{Min: 1024, Max: 49151}
+	EphemeralPorts  = PortRange{Min: 49152, Max: 65535}
+)
+
+// Port returns a uniformly random port in r from the default generator.
+//
+// Parameters:
+//   - r: Port range, e.g. EphemeralPorts.
+//
+// Returns:
+//   - uint16: A port in [r.Min, r.Max].
+//   - error: ErrNonPositiveBound if r.Min is 0, ErrMinGreaterThanMax if
+//     r.Min > r.Max, or an RNG error.
+func Port(r PortRange) (uint16, error) {
+	return Default().Port(r)
+}
+
+// Port returns a uniformly random port in r. Port 0 is reserved and never
+// returned.
+func (g *Generator) Port(r PortRange) (uint16, error) {
+	if r.Min == 0 {
+		return 0, &core.ArgError{Op: "Port", Arg: "Min", Value: r.Min, Err: core.ErrNonPositiveBound}
+	}
+	if r.Min > r.Max {
+		return 0, &core.RangeError{Op: "Port", Min: r.Min, Max: r.Max, Err: core.ErrMinGreaterThanMax}
+	}
+	return g.rng.Uint16Range(r.Min, r.Max)
+}
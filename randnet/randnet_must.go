@@ -0,0 +1,82 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randnet
+
+import (
+	"net"
+	"net/netip"
+)
+
+// MustIPv4 returns a uniformly random IPv4 address. It panics on error.
+func MustIPv4() netip.Addr {
+	a, err := IPv4()
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// MustIPv6 returns a uniformly random IPv6 address. It panics on error.
+func MustIPv6() netip.Addr {
+	a, err := IPv6()
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// MustIPInCIDR returns a random address inside cidr. It panics on error.
+func MustIPInCIDR(cidr string) netip.Addr {
+	a, err := IPInCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// MustPrivateIPv4 returns a random RFC 1918 address. It panics on error.
+func MustPrivateIPv4() netip.Addr {
+	a, err := PrivateIPv4()
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// MustMAC returns a random MAC address. It panics on error.
+func MustMAC(opts ...MACOption) net.HardwareAddr {
+	m, err := MAC(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// MustPort returns a random port in r. It panics on error.
+func MustPort(r PortRange) uint16 {
+	p, err := Port(r)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MustHostname returns a random hostname with labels labels. It panics on
+// error.
+func MustHostname(labels int) string {
+	h, err := Hostname(labels)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// MustURL returns a random URL configured by opts. It panics on error.
+func MustURL(opts URLOptions) string {
+	u, err := URL(opts)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
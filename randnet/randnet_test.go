@@ -0,0 +1,119 @@
+package randnet
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestIPv4AndIPv6(t *testing.T) {
+	a, err := IPv4()
+	if err != nil || !a.Is4() {
+		t.Fatalf("IPv4=%v err=%v", a, err)
+	}
+	b, err := IPv6()
+	if err != nil || !b.Is6() {
+		t.Fatalf("IPv6=%v err=%v", b, err)
+	}
+}
+
+func TestIPInCIDR(t *testing.T) {
+	for _, cidr := range []string{"10.1.0.0/16", "192.168.7.9/30", "2001:db8::/32", "203.0.113.5/32", "0.0.0.0/0", "fe80::/10", "10.0.0.0/13"} {
+		p := netip.MustParsePrefix(cidr).Masked()
+		seen := map[netip.Addr]bool{}
+		for i := 0; i < 200; i++ {
+			a, err := IPInCIDR(cidr)
+			if err != nil {
+				t.Fatalf("IPInCIDR(%s) error: %v", cidr, err)
+			}
+			if !p.Contains(a) {
+				t.Fatalf("IPInCIDR(%s)=%v outside prefix", cidr, a)
+			}
+			seen[a] = true
+		}
+		if p.Bits() == p.Addr().BitLen() && len(seen) != 1 {
+			t.Fatalf("single-address prefix %s yielded %d addresses", cidr, len(seen))
+		}
+		if cidr == "192.168.7.9/30" && len(seen) != 4 {
+			t.Fatalf("/30 covered %d of 4 addresses", len(seen))
+		}
+	}
+	if _, err := IPInCIDR("10.0.0.0/33"); !errors.Is(err, ErrInvalidCIDR) {
+		t.Fatalf("err=%v want ErrInvalidCIDR", err)
+	}
+	if _, err := Default().IPInPrefix(netip.Prefix{}); !errors.Is(err, ErrInvalidCIDR) {
+		t.Fatalf("err=%v want ErrInvalidCIDR", err)
+	}
+}
+
+func TestPrivateIPv4(t *testing.T) {
+	for i := 0; i < 300; i++ {
+		a, err := PrivateIPv4()
+		if err != nil {
+			t.Fatalf("PrivateIPv4 error: %v", err)
+		}
+		if !a.IsPrivate() || !a.Is4() {
+			t.Fatalf("PrivateIPv4=%v is not RFC 1918", a)
+		}
+	}
+}
+
+func TestMAC(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		m, err := MAC()
+		if err != nil {
+			t.Fatalf("MAC error: %v", err)
+		}
+		if len(m) != 6 || m[0]&macLocal == 0 || m[0]&macMulticast != 0 {
+			t.Fatalf("default MAC %v is not local unicast", m)
+		}
+		m, err = MAC(WithUniversal(), WithMulticast())
+		if err != nil {
+			t.Fatalf("MAC error: %v", err)
+		}
+		if m[0]&macLocal != 0 || m[0]&macMulticast == 0 {
+			t.Fatalf("MAC %v flags not honored", m)
+		}
+		m, err = MAC(WithOUI([3]byte{0x00, 0x1b, 0x63}))
+		if err != nil {
+			t.Fatalf("MAC error: %v", err)
+		}
+		if m[0] != 0x00 || m[1] != 0x1b || m[2] != 0x63 {
+			t.Fatalf("MAC %v does not keep OUI", m)
+		}
+	}
+}
+
+func TestPort(t *testing.T) {
+	for _, r := range []PortRange{AllPorts, WellKnownPorts, RegisteredPorts, EphemeralPorts, {Min: 8080, Max: 8080}} {
+		for i := 0; i < 100; i++ {
+			p, err := Port(r)
+			if err != nil {
+				t.Fatalf("Port(%v) error: %v", r, err)
+			}
+			if p < r.Min || p > r.Max {
+				t.Fatalf("Port(%v)=%d", r, p)
+			}
+		}
+	}
+	if _, err := Port(PortRange{Min: 0, Max: 10}); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := Port(PortRange{Min: 10, Max: 9}); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func TestRandnetPropagatesRNGError(t *testing.T) {
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.IPv6(); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+	if _, err := g.MAC(); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
@@ -0,0 +1,8 @@
+package randnet
+
+type rng interface {
+	Fill(p []byte) error
+	Uint64n(n uint64) (uint64, error)
+	IntRange(minInclusive, maxInclusive int) (int, error)
+	Uint16Range(minInclusive, maxInclusive uint16) (uint16, error)
+}
@@ -0,0 +1,164 @@
+package randnet
+
+import (
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+const (
+	pathChars  = lowerAlnum + "ABCDEFGHIJKLMNOPQRSTUVWXYZ-_~"
+	valueChars = lowerAlnum + "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// URLOptions configures URL. The zero value yields an http or https URL
+// with a two- or three-label host and no path or query.
+type URLOptions struct {
+	// Scheme is the URL scheme without "://". If empty, "http" or "https"
+	// is chosen at random.
+	Scheme string
+
+	// HostLabels is the number of labels in the host name, as for
+	// Hostname. If 0, two or three labels are used.
+	HostLabels int
+
+	// PathSegments is the number of non-empty "/segment" path elements.
+	PathSegments int
+
+	// QueryParams is the number of "key=value" query parameters.
+	QueryParams int
+
+	// MaxLength caps the length of the whole URL. If 0, there is no cap
+	// beyond the 253-character host name limit.
+	MaxLength int
+}
+
+// URL returns a random, syntactically valid URL from the default
+// generator. All characters are unreserved, so no percent-encoding is
+// needed and url.Parse round-trips the result.
+//
+// Parameters:
+//   - opts: Options controlling scheme, host depth, path, query and
+//     length.
+//
+// Returns:
+//   - string: A URL such as "https://qa7.vcx/Fo~2/b?k=Z9".
+//   - error: ErrInvalidScheme for a malformed Scheme, ErrNegativeLength or
+//     ErrNonPositiveBound for negative counts, ErrUnsatisfiable if the
+//     requested parts cannot fit in MaxLength, or an RNG error.
+func URL(opts URLOptions) (string, error) {
+	return Default().URL(opts)
+}
+
+// URL returns a random, syntactically valid URL.
+func (g *Generator) URL(opts URLOptions) (string, error) {
+	if opts.Scheme != "" && !validScheme(opts.Scheme) {
+		return "", &core.ArgError{Op: "URL", Arg: "Scheme", Value: opts.Scheme, Err: ErrInvalidScheme}
+	}
+	if opts.HostLabels < 0 || opts.HostLabels > maxHostnameLabels {
+		err := core.ErrNonPositiveBound
+		if opts.HostLabels > 0 {
+			err = core.ErrUnsatisfiable
+		}
+		return "", &core.ArgError{Op: "URL", Arg: "HostLabels", Value: opts.HostLabels, Err: err}
+	}
+	if opts.PathSegments < 0 {
+		return "", &core.ArgError{Op: "URL", Arg: "PathSegments", Value: opts.PathSegments, Err: core.ErrNegativeLength}
+	}
+	if opts.QueryParams < 0 {
+		return "", &core.ArgError{Op: "URL", Arg: "QueryParams", Value: opts.QueryParams, Err: core.ErrNegativeLength}
+	}
+	if opts.MaxLength < 0 {
+		return "", &core.ArgError{Op: "URL", Arg: "MaxLength", Value: opts.MaxLength, Err: core.ErrNegativeLength}
+	}
+
+	scheme := opts.Scheme
+	if scheme == "" {
+		scheme = "https"
+		coin, err := g.rng.Uint64n(2)
+		if err != nil {
+			return "", err
+		}
+		if coin == 0 {
+			scheme = "http"
+		}
+	}
+	labels := opts.HostLabels
+	if labels == 0 {
+		var err error
+		if labels, err = g.rng.IntRange(2, 3); err != nil {
+			return "", err
+		}
+	}
+
+	// Every path segment is at least "/x" and every parameter "?k=v".
+	minLen := len(scheme) + len("://") + minHostnameLen(labels) +
+		2*opts.PathSegments + 4*opts.QueryParams
+	slack := math.MaxInt
+	if opts.MaxLength > 0 {
+		if minLen > opts.MaxLength {
+			return "", &core.ArgError{Op: "URL", Arg: "MaxLength", Value: opts.MaxLength, Err: core.ErrUnsatisfiable}
+		}
+		slack = opts.MaxLength - minLen
+	}
+
+	hostSlack := min(slack, maxHostnameLen-minHostnameLen(labels))
+	spent := hostSlack
+	host, err := g.hostname(labels, &hostSlack)
+	if err != nil {
+		return "", err
+	}
+	slack -= spent - hostSlack
+
+	buf := make([]byte, 0, minLen+64)
+	buf = append(buf, scheme...)
+	buf = append(buf, "://"...)
+	buf = append(buf, host...)
+	for i := 0; i < opts.PathSegments; i++ {
+		n, err := g.length(1, 9, &slack)
+		if err != nil {
+			return "", err
+		}
+		buf = append(buf, '/')
+		if buf, err = g.appendFrom(buf, pathChars, n); err != nil {
+			return "", err
+		}
+	}
+	for i := 0; i < opts.QueryParams; i++ {
+		sep := byte('&')
+		if i == 0 {
+			sep = '?'
+		}
+		buf = append(buf, sep)
+		n, err := g.length(1, 7, &slack)
+		if err != nil {
+			return "", err
+		}
+		if buf, err = g.appendFrom(buf, lowerLetters, n); err != nil {
+			return "", err
+		}
+		buf = append(buf, '=')
+		if n, err = g.length(1, 11, &slack); err != nil {
+			return "", err
+		}
+		if buf, err = g.appendFrom(buf, valueChars, n); err != nil {
+			return "", err
+		}
+	}
+	return string(buf), nil
+}
+
+// validScheme reports whether s matches RFC 3986
+// ALPHA *( ALPHA / DIGIT / "+" / "-" / "." ).
+func validScheme(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case i > 0 && ('0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
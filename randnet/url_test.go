@@ -0,0 +1,124 @@
+package randnet
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+var labelRE = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+func checkHostname(t *testing.T, host string, labels int) {
+	t.Helper()
+	if len(host) > maxHostnameLen {
+		t.Fatalf("hostname too long: %d", len(host))
+	}
+	parts := strings.Split(host, ".")
+	if len(parts) != labels {
+		t.Fatalf("hostname %q has %d labels, want %d", host, len(parts), labels)
+	}
+	for i, p := range parts {
+		if !labelRE.MatchString(p) {
+			t.Fatalf("hostname %q has invalid label %q", host, p)
+		}
+		if labels > 1 && i == labels-1 && (len(p) < 2 || strings.Trim(p, lowerLetters) != "") {
+			t.Fatalf("hostname %q has invalid TLD %q", host, p)
+		}
+	}
+}
+
+func TestHostname(t *testing.T) {
+	for _, labels := range []int{1, 2, 5, 60, maxHostnameLabels} {
+		for i := 0; i < 50; i++ {
+			h, err := Hostname(labels)
+			if err != nil {
+				t.Fatalf("Hostname(%d) error: %v", labels, err)
+			}
+			checkHostname(t, h, labels)
+		}
+	}
+	if _, err := Hostname(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := Hostname(maxHostnameLabels + 1); !errors.Is(err, core.ErrUnsatisfiable) {
+		t.Fatalf("err=%v want ErrUnsatisfiable", err)
+	}
+}
+
+func TestURL(t *testing.T) {
+	cases := []URLOptions{
+		{},
+		{Scheme: "ftp", HostLabels: 4, PathSegments: 3, QueryParams: 2},
+		{Scheme: "svn+ssh", HostLabels: 1, PathSegments: 1},
+		{PathSegments: 5, QueryParams: 5, MaxLength: 60},
+		{HostLabels: 2, PathSegments: 2, QueryParams: 1, MaxLength: len("https://") + 4 + 4 + 4},
+	}
+	for _, opts := range cases {
+		for i := 0; i < 100; i++ {
+			s, err := URL(opts)
+			if err != nil {
+				t.Fatalf("URL(%+v) error: %v", opts, err)
+			}
+			if opts.MaxLength > 0 && len(s) > opts.MaxLength {
+				t.Fatalf("URL %q exceeds MaxLength %d", s, opts.MaxLength)
+			}
+			u, err := url.Parse(s)
+			if err != nil {
+				t.Fatalf("url.Parse(%q): %v", s, err)
+			}
+			if u.String() != s {
+				t.Fatalf("URL %q does not round-trip: %q", s, u.String())
+			}
+			if opts.Scheme != "" && u.Scheme != opts.Scheme {
+				t.Fatalf("scheme=%q want %q", u.Scheme, opts.Scheme)
+			}
+			if opts.Scheme == "" && u.Scheme != "http" && u.Scheme != "https" {
+				t.Fatalf("default scheme=%q", u.Scheme)
+			}
+			labels := opts.HostLabels
+			if labels == 0 {
+				labels = strings.Count(u.Host, ".") + 1
+				if labels < 2 || labels > 3 {
+					t.Fatalf("default host %q has %d labels", u.Host, labels)
+				}
+			}
+			checkHostname(t, u.Host, labels)
+			segs := 0
+			if u.Path != "" {
+				segs = strings.Count(u.Path, "/")
+			}
+			if segs != opts.PathSegments || strings.Contains(u.Path, "//") {
+				t.Fatalf("path %q, want %d segments", u.Path, opts.PathSegments)
+			}
+			if (opts.QueryParams == 0) != (u.RawQuery == "") ||
+				opts.QueryParams > 0 && strings.Count(u.RawQuery, "&") != opts.QueryParams-1 {
+				t.Fatalf("query %q, want %d params", u.RawQuery, opts.QueryParams)
+			}
+		}
+	}
+}
+
+func TestURLErrors(t *testing.T) {
+	cases := []struct {
+		opts URLOptions
+		want error
+	}{
+		{URLOptions{Scheme: "1http"}, ErrInvalidScheme},
+		{URLOptions{Scheme: "ht tp"}, ErrInvalidScheme},
+		{URLOptions{HostLabels: -1}, core.ErrNonPositiveBound},
+		{URLOptions{HostLabels: maxHostnameLabels + 1}, core.ErrUnsatisfiable},
+		{URLOptions{PathSegments: -1}, core.ErrNegativeLength},
+		{URLOptions{QueryParams: -1}, core.ErrNegativeLength},
+		{URLOptions{MaxLength: -1}, core.ErrNegativeLength},
+		{URLOptions{Scheme: "https", HostLabels: 2, MaxLength: 11}, core.ErrUnsatisfiable},
+	}
+	for _, tc := range cases {
+		if _, err := URL(tc.opts); !errors.Is(err, tc.want) {
+			t.Fatalf("URL(%+v) err=%v want %v", tc.opts, err, tc.want)
+		}
+	}
+}
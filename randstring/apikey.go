@@ -0,0 +1,78 @@
+package randstring
+
+import (
+	"hash/crc32"
+	"math"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// base62Alphabet orders digits before letters, as in GitHub tokens.
+const base62Alphabet = CharsetDigits + CharsetUpper + CharsetLower
+
+// apiKeyChecksumLen is the number of base62 characters needed for a CRC32.
+const apiKeyChecksumLen = 6
+
+// APIKey returns a prefixed, checksummed token such as
+// "sk_live_<random base62><checksum>". The random part carries at least
+// 8*nBytes bits of entropy and the last six characters are the base62
+// CRC32 of everything before them, so ValidateAPIKey can reject typos
+// without a database lookup. The prefix is used verbatim; include any
+// separator in it.
+// Note: strings are immutable and the key cannot be wiped after use.
+//
+// Parameters:
+//   - prefix: The literal prefix, e.g. "sk_live_".
+//   - nBytes: The entropy of the random part in bytes.
+//
+// Returns:
+//   - string: The API key.
+//   - error: An error if nBytes <= 0 or if crypto/rand fails.
+func APIKey(prefix string, nBytes int) (string, error) {
+	return Default().APIKey(prefix, nBytes)
+}
+
+// APIKey returns a prefixed, checksummed token using the generator's entropy
+// source.
+func (g *Generator) APIKey(prefix string, nBytes int) (string, error) {
+	if nBytes <= 0 {
+		return "", &core.ArgError{Op: "APIKey", Arg: "nBytes", Value: nBytes, Err: core.ErrNonPositiveBound}
+	}
+	chars := int(math.Ceil(float64(nBytes) * 8 / math.Log2(float64(len(base62Alphabet)))))
+	body, err := g.StringWithCharset(chars, base62Alphabet)
+	if err != nil {
+		return "", err
+	}
+	key := prefix + body
+	return key + apiKeyChecksum(key), nil
+}
+
+// ValidateAPIKey reports whether key carries a valid APIKey checksum. It
+// detects transcription errors only; it does not authenticate the key.
+//
+// Parameters:
+//   - key: The key to check.
+//
+// Returns:
+//   - error: core.ErrInvalidChecksum if the checksum is missing or wrong.
+func ValidateAPIKey(key string) error {
+	if len(key) <= apiKeyChecksumLen {
+		return core.ErrInvalidChecksum
+	}
+	split := len(key) - apiKeyChecksumLen
+	if apiKeyChecksum(key[:split]) != key[split:] {
+		return core.ErrInvalidChecksum
+	}
+	return nil
+}
+
+// apiKeyChecksum returns the CRC32 of s as six big-endian base62 digits.
+func apiKeyChecksum(s string) string {
+	sum := crc32.ChecksumIEEE([]byte(s))
+	var out [apiKeyChecksumLen]byte
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = base62Alphabet[sum%62]
+		sum /= 62
+	}
+	return string(out[:])
+}
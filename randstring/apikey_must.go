@@ -0,0 +1,13 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randstring
+
+// MustAPIKey returns a prefixed, checksummed token. It panics on error.
+func MustAPIKey(prefix string, nBytes int) string {
+	key, err := APIKey(prefix, nBytes)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
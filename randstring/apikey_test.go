@@ -0,0 +1,63 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestAPIKey(t *testing.T) {
+	key, err := APIKey("sk_live_", 24)
+	if err != nil {
+		t.Fatalf("APIKey error: %v", err)
+	}
+	if !strings.HasPrefix(key, "sk_live_") {
+		t.Fatalf("APIKey = %q missing prefix", key)
+	}
+	// 24 bytes need 33 base62 characters, plus the 6-character checksum.
+	body := strings.TrimPrefix(key, "sk_live_")
+	if len(body) != 33+6 || strings.Trim(body, base62Alphabet) != "" {
+		t.Fatalf("APIKey body %q has length %d", body, len(body))
+	}
+	if err := ValidateAPIKey(key); err != nil {
+		t.Fatalf("ValidateAPIKey(%q) error: %v", key, err)
+	}
+}
+
+func TestValidateAPIKeyDetectsTypos(t *testing.T) {
+	key, err := APIKey("ghp_", 16)
+	if err != nil {
+		t.Fatalf("APIKey error: %v", err)
+	}
+	for i := range key {
+		b := []byte(key)
+		if b[i] == 'x' {
+			b[i] = 'y'
+		} else {
+			b[i] = 'x'
+		}
+		if err := ValidateAPIKey(string(b)); !errors.Is(err, core.ErrInvalidChecksum) {
+			t.Fatalf("ValidateAPIKey accepted typo at %d: %q", i, b)
+		}
+	}
+	for _, bad := range []string{"", "abc", "abcdef"} {
+		if err := ValidateAPIKey(bad); !errors.Is(err, core.ErrInvalidChecksum) {
+			t.Fatalf("ValidateAPIKey(%q) err=%v", bad, err)
+		}
+	}
+}
+
+func TestAPIKeyChecksumKnownValue(t *testing.T) {
+	// crc32("hello") = 0x3610a686.
+	if got := apiKeyChecksum("hello"); got != "0zNvy2" {
+		t.Fatalf("apiKeyChecksum(hello) = %q", got)
+	}
+}
+
+func TestAPIKeyErrors(t *testing.T) {
+	if _, err := APIKey("k_", 0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("zero bytes err=%v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package randstring
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Common ASCII charsets for StringWithCharset.
+const (
+	CharsetUpper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	CharsetLower  = "abcdefghijklmnopqrstuvwxyz"
+	CharsetDigits = "0123456789"
+	// CharsetHex is the lower-case hexadecimal alphabet.
+	CharsetHex = "0123456789abcdef"
+	// CharsetBase58 is the Bitcoin base58 alphabet, which omits 0 O I l.
+	CharsetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// CharsetBase32Crockford is Crockford's base32 alphabet, which omits
+	// I L O U.
+	CharsetBase32Crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// CharsetURLSafe is the RFC 4648 URL-safe base64 alphabet.
+	CharsetURLSafe = CharsetUpper + CharsetLower + CharsetDigits + "-_"
+	// CharsetPrintableASCII holds the 94 visible ASCII characters from '!'
+	// to '~'; space is excluded.
+	CharsetPrintableASCII = "!\"#$%&'()*+,-./" + CharsetDigits + ":;<=>?@" +
+		CharsetUpper + "[\\]^_`" + CharsetLower + "{|}~"
+)
+
+// CharsetRegistry maps names to charsets so they can be selected from
+// configuration. It is safe for concurrent use.
+type CharsetRegistry struct {
+	mu       sync.RWMutex
+	charsets map[string]string
+}
+
+// Charsets is the package-wide registry. It starts with the names "upper",
+// "lower", "digits", "hex", "base58", "base32crockford", "urlsafe" and
+// "printable".
+var Charsets = newDefaultCharsets()
+
+// NewCharsetRegistry returns an empty registry.
+func NewCharsetRegistry() *CharsetRegistry {
+	return &CharsetRegistry{charsets: map[string]string{}}
+}
+
+func newDefaultCharsets() *CharsetRegistry {
+	r := NewCharsetRegistry()
+	for name, charset := range map[string]string{
+		"upper":           CharsetUpper,
+		"lower":           CharsetLower,
+		"digits":          CharsetDigits,
+		"hex":             CharsetHex,
+		"base58":          CharsetBase58,
+		"base32crockford": CharsetBase32Crockford,
+		"urlsafe":         CharsetURLSafe,
+		"printable":       CharsetPrintableASCII,
+	} {
+		r.charsets[name] = charset
+	}
+	return r
+}
+
+// Register adds or replaces the charset stored under name. It returns
+// core.ErrEmptyCharset if charset is empty and core.ErrInvalidCharset if it
+// is not ASCII.
+func (r *CharsetRegistry) Register(name string, charset string) error {
+	if charset == "" {
+		return core.ErrEmptyCharset
+	}
+	if !isASCIICharset(charset) {
+		return core.ErrInvalidCharset
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.charsets[name] = charset
+	return nil
+}
+
+// Lookup returns the charset registered under name.
+func (r *CharsetRegistry) Lookup(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	charset, ok := r.charsets[name]
+	return charset, ok
+}
+
+// Names returns the registered names in sorted order.
+func (r *CharsetRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.charsets))
+	for name := range r.charsets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Combine concatenates the charsets registered under names, keeping only
+// the first occurrence of each character so that overlapping sets do not
+// bias StringWithCharset. It returns core.ErrUnknownCharset if a name is not
+// registered.
+func (r *CharsetRegistry) Combine(names ...string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	charsets := make([]string, len(names))
+	for i, name := range names {
+		charset, ok := r.charsets[name]
+		if !ok {
+			return "", &core.ArgError{Op: "Combine", Arg: "name", Value: name, Err: core.ErrUnknownCharset}
+		}
+		charsets[i] = charset
+	}
+	return CombineCharsets(charsets...), nil
+}
+
+// CombineCharsets concatenates charsets, keeping only the first occurrence
+// of each character so that overlapping sets do not bias StringWithCharset.
+func CombineCharsets(charsets ...string) string {
+	var seen [256]bool
+	var b strings.Builder
+	for _, charset := range charsets {
+		for i := 0; i < len(charset); i++ {
+			if !seen[charset[i]] {
+				seen[charset[i]] = true
+				b.WriteByte(charset[i])
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,90 @@
+package randstring
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestCharsetConstants(t *testing.T) {
+	tests := []struct {
+		name    string
+		charset string
+		size    int
+	}{
+		{"upper", CharsetUpper, 26},
+		{"lower", CharsetLower, 26},
+		{"digits", CharsetDigits, 10},
+		{"hex", CharsetHex, 16},
+		{"base58", CharsetBase58, 58},
+		{"base32crockford", CharsetBase32Crockford, 32},
+		{"urlsafe", CharsetURLSafe, 64},
+		{"printable", CharsetPrintableASCII, 94},
+	}
+	for _, tt := range tests {
+		if len(tt.charset) != tt.size {
+			t.Fatalf("%s has %d chars want %d", tt.name, len(tt.charset), tt.size)
+		}
+		if CombineCharsets(tt.charset) != tt.charset {
+			t.Fatalf("%s contains duplicate characters", tt.name)
+		}
+		got, ok := Charsets.Lookup(tt.name)
+		if !ok || got != tt.charset {
+			t.Fatalf("Lookup(%q) = %q, %v", tt.name, got, ok)
+		}
+	}
+	for c := byte('!'); c <= '~'; c++ {
+		if strings.IndexByte(CharsetPrintableASCII, c) < 0 {
+			t.Fatalf("printable charset missing %q", c)
+		}
+	}
+}
+
+func TestCharsetRegistry(t *testing.T) {
+	r := NewCharsetRegistry()
+	if err := r.Register("vowels", "aeiou"); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if err := r.Register("empty", ""); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("empty charset err=%v", err)
+	}
+	if err := r.Register("greek", "αβ"); !errors.Is(err, core.ErrInvalidCharset) {
+		t.Fatalf("non-ASCII charset err=%v", err)
+	}
+	if names := r.Names(); !slices.Equal(names, []string{"vowels"}) {
+		t.Fatalf("Names = %v", names)
+	}
+	if _, ok := r.Lookup("missing"); ok {
+		t.Fatalf("Lookup found unregistered name")
+	}
+}
+
+func TestCombineCharsets(t *testing.T) {
+	got := CombineCharsets(CharsetHex, CharsetDigits, "fgf")
+	if got != CharsetHex+"g" {
+		t.Fatalf("CombineCharsets = %q want %q", got, CharsetHex+"g")
+	}
+	if got := CombineCharsets(); got != "" {
+		t.Fatalf("CombineCharsets() = %q want empty", got)
+	}
+}
+
+func TestCharsetsCombine(t *testing.T) {
+	got, err := Charsets.Combine("hex", "digits", "upper")
+	if err != nil || got != CharsetHex+"ABCDEFGHIJKLMNOPQRSTUVWXYZ" {
+		t.Fatalf("Combine = %q, %v", got, err)
+	}
+	r := NewCharsetRegistry()
+	if err := r.Register("vowels", "aeiou"); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if got, err := r.Combine("vowels"); err != nil || got != "aeiou" {
+		t.Fatalf("Combine(vowels) = %q, %v", got, err)
+	}
+	if _, err := r.Combine("vowels", "hex"); !errors.Is(err, core.ErrUnknownCharset) {
+		t.Fatalf("unregistered name err=%v want ErrUnknownCharset", err)
+	}
+}
@@ -0,0 +1,46 @@
+package randstring
+
+import (
+	"encoding/base32"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// crockfordEncoding is unpadded base32 with Crockford's alphabet.
+var crockfordEncoding = base32.NewEncoding(CharsetBase32Crockford).WithPadding(base32.NoPadding)
+
+// base58Encode encodes b as a big-endian base58 number using the Bitcoin
+// alphabet. Each leading zero byte becomes a leading '1'.
+func base58Encode(b []byte) []byte {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	// log(256)/log(58) < 138/100, so size digits always suffice.
+	size := (len(b)-zeros)*138/100 + 1
+	digits := make([]byte, size)
+	defer core.Zero(digits)
+	high := size - 1
+	for _, v := range b[zeros:] {
+		carry := int(v)
+		j := size - 1
+		for ; j > high || carry != 0; j-- {
+			carry += 256 * int(digits[j])
+			digits[j] = byte(carry % 58)
+			carry /= 58
+		}
+		high = j
+	}
+	start := 0
+	for start < size && digits[start] == 0 {
+		start++
+	}
+	out := make([]byte, zeros+size-start)
+	for i := 0; i < zeros; i++ {
+		out[i] = CharsetBase58[0]
+	}
+	for i, d := range digits[start:] {
+		out[zeros+i] = CharsetBase58[d]
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package randstring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestBase58EncodeVectors(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		want string
+	}{
+		{nil, ""},
+		{[]byte{0}, "1"},
+		{[]byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}, "11233QC4"},
+		{[]byte("Hello World!"), "2NEpo7TZRRrLZSi2U"},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, "7YXq9G"},
+	}
+	for _, tt := range tests {
+		if got := string(base58Encode(tt.in)); got != tt.want {
+			t.Fatalf("base58Encode(%x) = %q want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTokenBase58(t *testing.T) {
+	tok, err := TokenBase58(32)
+	if err != nil {
+		t.Fatalf("TokenBase58 error: %v", err)
+	}
+	if len(tok) == 0 || len(tok) > 44 || strings.Trim(tok, CharsetBase58) != "" {
+		t.Fatalf("TokenBase58 = %q", tok)
+	}
+	gen := NewWithSource(testutil.NewSeqReader([]byte("Hello World!")))
+	b, err := gen.TokenBase58Bytes(12)
+	if err != nil || string(b) != "2NEpo7TZRRrLZSi2U" {
+		t.Fatalf("TokenBase58Bytes = %q err: %v", b, err)
+	}
+}
+
+func TestTokenBase32Crockford(t *testing.T) {
+	tok, err := TokenBase32Crockford(10)
+	if err != nil {
+		t.Fatalf("TokenBase32Crockford error: %v", err)
+	}
+	if len(tok) != 16 || strings.Trim(tok, CharsetBase32Crockford) != "" {
+		t.Fatalf("TokenBase32Crockford = %q", tok)
+	}
+	gen := NewWithSource(testutil.NewSeqReader([]byte("foobar")))
+	s, err := gen.TokenBase32Crockford(6)
+	if err != nil || s != "CSQPYRK1E8" {
+		t.Fatalf("TokenBase32Crockford = %q err: %v want CSQPYRK1E8", s, err)
+	}
+	gen = NewWithSource(testutil.NewSeqReader([]byte("foobar")))
+	b, err := gen.TokenBase32CrockfordBytes(6)
+	if err != nil || string(b) != "CSQPYRK1E8" {
+		t.Fatalf("TokenBase32CrockfordBytes = %q err: %v", b, err)
+	}
+}
@@ -44,43 +44,72 @@ func Default() *Generator {
 // Returns:
 //   - string: A random string of the specified length.
 //   - error: An error if length < 0, charset is empty/invalid, or if entropy fails.
+//
+// The charset must be ASCII; use StringWithRunes for multi-byte alphabets.
 func (g *Generator) StringWithCharset(length int, charset string) (string, error) {
+	b, err := g.charsetBytes(length, charset)
+	if err != nil {
+		return "", err
+	}
+	defer core.Zero(b)
+	return string(b), nil
+}
+
+// charsetBytes implements StringWithCharset, returning a byte slice the
+// caller owns and may wipe.
+func (g *Generator) charsetBytes(length int, charset string) ([]byte, error) {
 	if length < 0 {
-		return "", core.ErrNegativeLength
+		return nil, core.ErrNegativeLength
 	}
+	if err := checkCharset(charset); err != nil {
+		return nil, err
+	}
+	if length == 0 {
+		return nil, nil
+	}
+	out := make([]byte, length)
+	if err := g.fillCharset(out, charset); err != nil {
+		core.Zero(out)
+		return nil, err
+	}
+	return out, nil
+}
+
+func checkCharset(charset string) error {
 	if len(charset) == 0 {
-		return "", core.ErrEmptyCharset
+		return core.ErrEmptyCharset
 	}
 	if !isASCIICharset(charset) {
-		return "", core.ErrInvalidCharset
-	}
-	if length == 0 {
-		return "", nil
+		return core.ErrInvalidCharset
 	}
-	charsetBytes := []byte(charset)
-	if len(charsetBytes) == 1 {
-		out := make([]byte, length)
+	return nil
+}
+
+// fillCharset fills out with characters drawn uniformly from charset, which
+// the caller has validated with checkCharset.
+func (g *Generator) fillCharset(out []byte, charset string) error {
+	length := len(out)
+	n := len(charset)
+	if n == 1 {
 		for i := range out {
-			out[i] = charsetBytes[0]
+			out[i] = charset[0]
 		}
-		return string(out), nil
+		return nil
 	}
 
-	out := make([]byte, length)
-	n := len(charsetBytes)
 	if n > 256 {
 		for i := range out {
 			idx, err := g.rng.Uint64n(uint64(n))
 			if err != nil {
-				return "", err
+				return err
 			}
 			pos, err := u64ToInt(idx)
 			if err != nil {
-				return "", err
+				return err
 			}
-			out[i] = charsetBytes[pos]
+			out[i] = charset[pos]
 		}
-		return string(out), nil
+		return nil
 	}
 
 	buf := make([]byte, 128)
@@ -89,37 +118,37 @@ func (g *Generator) StringWithCharset(length int, charset string) (string, error
 		pos := 0
 		for pos < length {
 			if err := g.rng.Fill(buf); err != nil {
-				return "", err
+				return err
 			}
 			for _, b := range buf {
-				out[pos] = charsetBytes[int(b&mask)]
+				out[pos] = charset[int(b&mask)]
 				pos++
 				if pos == length {
 					break
 				}
 			}
 		}
-		return string(out), nil
+		return nil
 	}
 
 	acceptLimit := 256 - (256 % n)
 	pos := 0
 	for pos < length {
 		if err := g.rng.Fill(buf); err != nil {
-			return "", err
+			return err
 		}
 		for _, b := range buf {
 			if int(b) >= acceptLimit {
 				continue
 			}
-			out[pos] = charsetBytes[int(b)%n]
+			out[pos] = charset[int(b)%n]
 			pos++
 			if pos == length {
 				break
 			}
 		}
 	}
-	return string(out), nil
+	return nil
 }
 
 // String returns a random string of length characters drawn from the
@@ -132,7 +161,7 @@ func (g *Generator) StringWithCharset(length int, charset string) (string, error
 //   - string: A random string of the specified length.
 //   - error: An error if length < 0 or if entropy fails.
 func (g *Generator) String(length int) (string, error) {
-	return g.StringWithCharset(length, lowerCaseAndNumbers)
+	return g.StringWithCharset(length, CharsetLower+CharsetDigits)
 }
 
 // Base64 returns a base64 string built from byteLen random bytes using
@@ -264,6 +293,71 @@ func (g *Generator) TokenURLSafeBytes(nBytes int) ([]byte, error) {
 	return out, nil
 }
 
+// TokenBase58 returns a Bitcoin-style base58 string encoding nBytes of
+// random data. Leading zero bytes encode as '1', so the length varies.
+// Note: strings are immutable; use TokenBase58Bytes if you need to wipe secrets.
+//
+// Parameters:
+//   - nBytes: The number of random bytes to generate.
+//
+// Returns:
+//   - string: A base58 string of at most ceil(nBytes*log(256)/log(58))
+//     characters.
+//   - error: An error if nBytes < 0 or if entropy fails.
+func (g *Generator) TokenBase58(nBytes int) (string, error) {
+	b, err := g.TokenBase58Bytes(nBytes)
+	if err != nil {
+		return "", err
+	}
+	defer core.Zero(b)
+	return string(b), nil
+}
+
+// TokenBase58Bytes returns a base58 token as a byte slice.
+// Callers may zero the returned slice after use.
+func (g *Generator) TokenBase58Bytes(nBytes int) ([]byte, error) {
+	b, err := g.rng.Bytes(nBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer core.Zero(b)
+	return base58Encode(b), nil
+}
+
+// TokenBase32Crockford returns an unpadded Crockford base32 string encoding
+// nBytes of random data. The alphabet omits I, L, O and U, so tokens are
+// easy to read aloud and transcribe.
+// Note: strings are immutable; use TokenBase32CrockfordBytes if you need to
+// wipe secrets.
+//
+// Parameters:
+//   - nBytes: The number of random bytes to generate.
+//
+// Returns:
+//   - string: A Crockford base32 string of length ceil(8*nBytes/5).
+//   - error: An error if nBytes < 0 or if entropy fails.
+func (g *Generator) TokenBase32Crockford(nBytes int) (string, error) {
+	b, err := g.rng.Bytes(nBytes)
+	if err != nil {
+		return "", err
+	}
+	defer core.Zero(b)
+	return crockfordEncoding.EncodeToString(b), nil
+}
+
+// TokenBase32CrockfordBytes returns a Crockford base32 token as a byte slice.
+// Callers may zero the returned slice after use.
+func (g *Generator) TokenBase32CrockfordBytes(nBytes int) ([]byte, error) {
+	b, err := g.rng.Bytes(nBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer core.Zero(b)
+	out := make([]byte, crockfordEncoding.EncodedLen(len(b)))
+	crockfordEncoding.Encode(out, b)
+	return out, nil
+}
+
 // StringSlice returns a slice of random strings with per-item length in
 // [minStrLen, maxStrLen], using the generator's entropy source.
 func (g *Generator) StringSlice(
@@ -307,10 +401,3 @@ func u64ToInt(v uint64) (int, error) {
 func isPowerOfTwo(n int) bool {
 	return n > 0 && (n&(n-1)) == 0
 }
-
-// Predefined character sets.
-const (
-	lowerCase           = "abcdefghijklmnopqrstuvwxyz"
-	numbers             = "0123456789"
-	lowerCaseAndNumbers = lowerCase + numbers
-)
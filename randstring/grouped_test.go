@@ -0,0 +1,56 @@
+package randstring
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestGrouped(t *testing.T) {
+	code, err := Grouped(12, 4, '-', CharsetUpper+CharsetDigits)
+	if err != nil {
+		t.Fatalf("Grouped error: %v", err)
+	}
+	if !regexp.MustCompile(`^[A-Z0-9]{4}-[A-Z0-9]{4}-[A-Z0-9]{4}$`).MatchString(code) {
+		t.Fatalf("Grouped = %q", code)
+	}
+
+	code, err = Grouped(7, 3, '·', "ab")
+	if err != nil {
+		t.Fatalf("Grouped error: %v", err)
+	}
+	if parts := strings.Split(code, "·"); len(parts) != 3 || len(parts[2]) != 1 {
+		t.Fatalf("Grouped = %q want groups of 3,3,1", code)
+	}
+
+	if code, err := Grouped(0, 4, '-', "ab"); err != nil || code != "" {
+		t.Fatalf("Grouped(0) = %q err: %v", code, err)
+	}
+}
+
+func TestGroupedWithoutAmbiguous(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		code, err := Grouped(40, 5, '-', CharsetUpper+CharsetDigits, WithoutAmbiguous())
+		if err != nil {
+			t.Fatalf("Grouped error: %v", err)
+		}
+		if strings.ContainsAny(code, ambiguousChars) {
+			t.Fatalf("Grouped = %q contains ambiguous characters", code)
+		}
+	}
+	if _, err := Grouped(4, 2, '-', "0O1", WithoutAmbiguous()); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("all-ambiguous charset err=%v", err)
+	}
+}
+
+func TestGroupedErrors(t *testing.T) {
+	if _, err := Grouped(4, 0, '-', "ab"); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("zero groupLen err=%v", err)
+	}
+	if _, err := Grouped(-1, 2, '-', "ab"); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative totalLen err=%v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package randstring
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// markovEnd marks the end of a word in the transition table. The start of a
+// word is represented by a state made of order markovEnd runes.
+const markovEnd = rune(0)
+
+// Markov generates plausible-looking words from a character-level Markov
+// chain trained on a corpus, for fixture data that reads naturally.
+//
+// Concurrency: safe for concurrent use if the underlying RNG is safe.
+type Markov struct {
+	gen    *Generator
+	order  int
+	maxLen int
+	states map[string]*markovState
+}
+
+// markovState holds the observed successors of a state with cumulative
+// counts for weighted selection.
+type markovState struct {
+	next  []rune
+	cum   []uint64
+	index map[rune]int
+}
+
+// NewMarkov trains a Markov chain of the given order on corpus using the
+// active entropy source. Higher orders copy longer runs of the corpus;
+// 2 or 3 suit name lists.
+//
+// Parameters:
+//   - corpus: The training words; empty entries are ignored.
+//   - order: The number of preceding characters that select the next one.
+//
+// Returns:
+//   - *Markov: The trained generator.
+//   - error: An error if order <= 0 or corpus has no non-empty words.
+func NewMarkov(corpus []string, order int) (*Markov, error) {
+	return Default().NewMarkov(corpus, order)
+}
+
+// NewMarkov trains a Markov chain on corpus that draws from the generator's
+// entropy source.
+func (g *Generator) NewMarkov(corpus []string, order int) (*Markov, error) {
+	if order <= 0 {
+		return nil, &core.ArgError{Op: "NewMarkov", Arg: "order", Value: order, Err: core.ErrNonPositiveBound}
+	}
+	m := &Markov{gen: g, order: order, states: map[string]*markovState{}}
+	start := strings.Repeat(string(markovEnd), order)
+	for _, word := range corpus {
+		if word == "" {
+			continue
+		}
+		runes := []rune(start + word)
+		m.maxLen = max(m.maxLen, len(runes)-order)
+		for i := order; i <= len(runes); i++ {
+			next := markovEnd
+			if i < len(runes) {
+				next = runes[i]
+			}
+			m.observe(string(runes[i-order:i]), next)
+		}
+	}
+	if len(m.states) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	return m, nil
+}
+
+func (m *Markov) observe(state string, next rune) {
+	s := m.states[state]
+	if s == nil {
+		s = &markovState{index: map[rune]int{}}
+		m.states[state] = s
+	}
+	i, ok := s.index[next]
+	if !ok {
+		i = len(s.next)
+		s.index[next] = i
+		s.next = append(s.next, next)
+		var last uint64
+		if len(s.cum) > 0 {
+			last = s.cum[len(s.cum)-1]
+		}
+		s.cum = append(s.cum, last)
+	}
+	for j := i; j < len(s.cum); j++ {
+		s.cum[j]++
+	}
+}
+
+// Word returns a generated word. Words are capped at twice the length of the
+// longest corpus word.
+func (m *Markov) Word() (string, error) {
+	state := []rune(strings.Repeat(string(markovEnd), m.order))
+	var b strings.Builder
+	for n := 0; n < 2*m.maxLen; n++ {
+		s := m.states[string(state)]
+		if s == nil {
+			break
+		}
+		total := s.cum[len(s.cum)-1]
+		u, err := m.gen.rng.Uint64n(total)
+		if err != nil {
+			return "", err
+		}
+		next := s.next[sort.Search(len(s.cum), func(i int) bool { return s.cum[i] > u })]
+		if next == markovEnd {
+			break
+		}
+		b.WriteRune(next)
+		state = append(state[1:], next)
+	}
+	return b.String(), nil
+}
+
+// Words returns n generated words.
+func (m *Markov) Words(n int) ([]string, error) {
+	if n < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	out := make([]string, n)
+	for i := range out {
+		w, err := m.Word()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = w
+	}
+	return out, nil
+}
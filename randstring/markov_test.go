@@ -0,0 +1,76 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+var markovNames = []string{
+	"alice", "alina", "alma", "amelia", "anna", "aria", "ava", "bella",
+	"carla", "clara", "elena", "ella", "emma", "eva", "helena", "isabella",
+	"julia", "lena", "lina", "luna", "maria", "marina", "mila", "nina",
+}
+
+func TestMarkovWords(t *testing.T) {
+	m, err := NewMarkov(markovNames, 2)
+	if err != nil {
+		t.Fatalf("NewMarkov error: %v", err)
+	}
+	words, err := m.Words(200)
+	if err != nil {
+		t.Fatalf("Words error: %v", err)
+	}
+	letters := strings.Join(markovNames, "")
+	for _, w := range words {
+		if w == "" || utf8.RuneCountInString(w) > 2*len("isabella") {
+			t.Fatalf("word %q has invalid length", w)
+		}
+		if strings.Trim(w, letters) != "" {
+			t.Fatalf("word %q uses letters outside the corpus", w)
+		}
+		// Every trigram must have been seen in the corpus.
+		padded := "\x00\x00" + w + "\x00"
+		for i := 0; i+3 <= len(padded); i++ {
+			if !markovSeen(padded[i : i+3]) {
+				t.Fatalf("word %q contains unseen trigram %q", w, padded[i:i+3])
+			}
+		}
+	}
+}
+
+func markovSeen(tri string) bool {
+	for _, name := range markovNames {
+		if strings.Contains("\x00\x00"+name+"\x00", tri) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMarkovSingleWordCorpus(t *testing.T) {
+	m, err := NewMarkov([]string{"", "ωmega"}, 1)
+	if err != nil {
+		t.Fatalf("NewMarkov error: %v", err)
+	}
+	w, err := m.Word()
+	if err != nil || w != "ωmega" {
+		t.Fatalf("Word = %q err: %v want ωmega", w, err)
+	}
+}
+
+func TestMarkovErrors(t *testing.T) {
+	if _, err := NewMarkov(markovNames, 0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("zero order err=%v", err)
+	}
+	if _, err := NewMarkov([]string{"", ""}, 2); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("empty corpus err=%v", err)
+	}
+	m, _ := NewMarkov(markovNames, 2)
+	if _, err := m.Words(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative count err=%v", err)
+	}
+}
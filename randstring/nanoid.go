@@ -0,0 +1,51 @@
+package randstring
+
+// Nano ID defaults. These are the single definition; the nanoid package
+// re-exports them as DefaultAlphabet and DefaultLength.
+const (
+	// NanoIDAlphabet is the 64-character URL-safe Nano ID alphabet. It has
+	// the same characters as the reference urlAlphabet but a different
+	// order, so seeded output differs from the reference implementation.
+	NanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// NanoIDLength is the default Nano ID length, giving 126 bits of
+	// entropy with NanoIDAlphabet.
+	NanoIDLength = 21
+)
+
+// NanoID returns a Nano ID of NanoIDLength characters drawn uniformly from
+// NanoIDAlphabet. IDs have the same length and character set as the
+// reference implementation's, so either can validate the other's.
+//
+// Returns:
+//   - string: A random Nano ID.
+//   - error: An error if crypto/rand fails.
+func NanoID() (string, error) {
+	return Default().NanoID()
+}
+
+// NanoIDWithAlphabet returns a Nano ID of size characters drawn uniformly
+// from alphabet, like the reference customAlphabet. Characters should be
+// unique; repeated characters are drawn proportionally more often.
+//
+// Parameters:
+//   - alphabet: The ASCII alphabet to draw from.
+//   - size: The number of characters to generate.
+//
+// Returns:
+//   - string: A random Nano ID.
+//   - error: An error if size < 0, alphabet is empty or not ASCII, or if
+//     crypto/rand fails.
+func NanoIDWithAlphabet(alphabet string, size int) (string, error) {
+	return Default().NanoIDWithAlphabet(alphabet, size)
+}
+
+// NanoID returns a default Nano ID using the generator's entropy source.
+func (g *Generator) NanoID() (string, error) {
+	return g.StringWithCharset(NanoIDLength, NanoIDAlphabet)
+}
+
+// NanoIDWithAlphabet returns a Nano ID of size characters drawn from
+// alphabet using the generator's entropy source.
+func (g *Generator) NanoIDWithAlphabet(alphabet string, size int) (string, error) {
+	return g.StringWithCharset(size, alphabet)
+}
@@ -0,0 +1,23 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randstring
+
+// MustNanoID returns a canonical Nano ID. It panics on error.
+func MustNanoID() string {
+	id, err := NanoID()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MustNanoIDWithAlphabet returns a Nano ID of size characters drawn from
+// alphabet. It panics on error.
+func MustNanoIDWithAlphabet(alphabet string, size int) string {
+	id, err := NanoIDWithAlphabet(alphabet, size)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
@@ -0,0 +1,49 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestNanoID(t *testing.T) {
+	id, err := NanoID()
+	if err != nil {
+		t.Fatalf("NanoID error: %v", err)
+	}
+	if len(id) != NanoIDLength || strings.Trim(id, NanoIDAlphabet) != "" {
+		t.Fatalf("NanoID = %q, want %d chars from the URL-safe alphabet", id, NanoIDLength)
+	}
+}
+
+func TestNanoIDMasking(t *testing.T) {
+	// Like the reference implementation, each random byte maps through
+	// alphabet[b&63], so the bytes 0..20 must yield the first 21 characters.
+	raw := make([]byte, 128)
+	for i := range raw {
+		raw[i] = byte(i) | 0xC0
+	}
+	id, err := NewWithSource(testutil.NewSeqReader(raw)).NanoID()
+	if err != nil || id != NanoIDAlphabet[:NanoIDLength] {
+		t.Fatalf("NanoID = %q err: %v want %q", id, err, NanoIDAlphabet[:NanoIDLength])
+	}
+}
+
+func TestNanoIDWithAlphabet(t *testing.T) {
+	id, err := NanoIDWithAlphabet("1234567890abcdef", 10)
+	if err != nil {
+		t.Fatalf("NanoIDWithAlphabet error: %v", err)
+	}
+	if len(id) != 10 || strings.Trim(id, "1234567890abcdef") != "" {
+		t.Fatalf("NanoIDWithAlphabet = %q", id)
+	}
+	if _, err := NanoIDWithAlphabet("", 5); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("empty alphabet err=%v", err)
+	}
+	if _, err := NanoIDWithAlphabet("abc", -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative size err=%v", err)
+	}
+}
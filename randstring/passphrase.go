@@ -0,0 +1,211 @@
+package randstring
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+//go:embed wordlists/eff_large_wordlist.txt
+var defaultWordlistText string
+
+var defaultWordlist = sync.OnceValue(func() []string {
+	words, err := ParseWordlist(strings.NewReader(defaultWordlistText))
+	if err != nil {
+		panic(err)
+	}
+	// Drop the few hyphenated entries ("t-shirt", "yo-yo", ...) so that
+	// passphrases joined with the default "-" separator split back into
+	// their words.
+	out := words[:0]
+	for _, w := range words {
+		if !strings.Contains(w, "-") {
+			out = append(out, w)
+		}
+	}
+	return out
+})
+
+// DefaultWordlist returns a copy of the embedded EFF large wordlist without
+// its four hyphenated entries: 7772 distinct words of lower-case ASCII
+// letters only, so each word adds log2(7772) ≈ 12.9 bits of entropy and a
+// passphrase joined with "-" splits back into its words. The list is
+// published by the Electronic Frontier Foundation under CC BY 3.0 US; see
+// wordlists/LICENSE.
+func DefaultWordlist() []string {
+	return append([]string(nil), defaultWordlist()...)
+}
+
+type passphraseConfig struct {
+	words      []string
+	separator  string
+	capitalize bool
+	digits     int
+}
+
+// PassphraseOption configures Passphrase.
+type PassphraseOption func(*passphraseConfig)
+
+// WithSeparator sets the string placed between words. The default is "-".
+func WithSeparator(sep string) PassphraseOption {
+	return func(c *passphraseConfig) { c.separator = sep }
+}
+
+// WithCapitalize upper-cases the first letter of every word. It adds no
+// entropy but satisfies policies that require capitals.
+func WithCapitalize() PassphraseOption {
+	return func(c *passphraseConfig) { c.capitalize = true }
+}
+
+// WithDigits appends n random digits after the last word, preceded by the
+// separator.
+func WithDigits(n int) PassphraseOption {
+	return func(c *passphraseConfig) { c.digits = n }
+}
+
+// WithWordlist draws words from words instead of DefaultWordlist, for
+// example a diceware file loaded with ParseWordlist. The slice is copied, so
+// later changes by the caller do not affect the option. Passphrase rejects
+// lists with empty or repeated words with core.ErrInvalidWordlist.
+func WithWordlist(words []string) PassphraseOption {
+	if words != nil {
+		words = append([]string{}, words...)
+	}
+	return func(c *passphraseConfig) { c.words = words }
+}
+
+// Passphrase returns a diceware-style passphrase of words words chosen
+// uniformly and independently from the wordlist.
+//
+// Parameters:
+//   - words: The number of words, > 0.
+//   - opts: Separator, capitalization, digit and wordlist options.
+//
+// Returns:
+//   - string: The passphrase.
+//   - error: An error if words <= 0, the digit count is negative, the
+//     wordlist is empty, or if crypto/rand fails.
+func Passphrase(words int, opts ...PassphraseOption) (string, error) {
+	return Default().Passphrase(words, opts...)
+}
+
+// PassphraseEntropyBits reports the entropy in bits of a passphrase generated
+// with the same arguments: words*log2(len(wordlist)) plus log2(10) per digit.
+//
+// Parameters:
+//   - words: The number of words, > 0.
+//   - opts: The options that will be passed to Passphrase.
+//
+// Returns:
+//   - float64: The entropy in bits.
+//   - error: An error if the arguments are invalid.
+func PassphraseEntropyBits(words int, opts ...PassphraseOption) (float64, error) {
+	cfg, err := newPassphraseConfig("PassphraseEntropyBits", words, opts)
+	if err != nil {
+		return 0, err
+	}
+	return float64(words)*math.Log2(float64(len(cfg.words))) + float64(cfg.digits)*math.Log2(10), nil
+}
+
+// Passphrase returns a diceware-style passphrase using the generator's
+// entropy source.
+func (g *Generator) Passphrase(words int, opts ...PassphraseOption) (string, error) {
+	cfg, err := newPassphraseConfig("Passphrase", words, opts)
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, 0, words+1)
+	for i := 0; i < words; i++ {
+		// #nosec G115 -- the wordlist length is positive.
+		idx, err := g.rng.Uint64n(uint64(len(cfg.words)))
+		if err != nil {
+			return "", err
+		}
+		w := cfg.words[idx]
+		if cfg.capitalize && w != "" {
+			r, size := utf8.DecodeRuneInString(w)
+			w = string(unicode.ToUpper(r)) + w[size:]
+		}
+		parts = append(parts, w)
+	}
+	if cfg.digits > 0 {
+		d, err := g.StringWithCharset(cfg.digits, CharsetDigits)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, d)
+	}
+	return strings.Join(parts, cfg.separator), nil
+}
+
+func newPassphraseConfig(op string, words int, opts []PassphraseOption) (passphraseConfig, error) {
+	cfg := passphraseConfig{separator: "-"}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	custom := cfg.words != nil
+	if !custom {
+		cfg.words = defaultWordlist()
+	}
+	if words <= 0 {
+		return cfg, &core.ArgError{Op: op, Arg: "words", Value: words, Err: core.ErrNonPositiveBound}
+	}
+	if cfg.digits < 0 {
+		return cfg, &core.ArgError{Op: op, Arg: "digits", Value: cfg.digits, Err: core.ErrNegativeLength}
+	}
+	if len(cfg.words) == 0 {
+		return cfg, &core.ArgError{Op: op, Arg: "wordlist", Value: 0, Err: core.ErrEmptyItems}
+	}
+	if custom {
+		// Empty or repeated words would make PassphraseEntropyBits
+		// overstate the strength, as ParseWordlist already guards against.
+		seen := make(map[string]bool, len(cfg.words))
+		for _, w := range cfg.words {
+			if w == "" || seen[w] {
+				return cfg, &core.ArgError{Op: op, Arg: "wordlist", Value: w, Err: core.ErrInvalidWordlist}
+			}
+			seen[w] = true
+		}
+	}
+	return cfg, nil
+}
+
+// ParseWordlist reads one word per line, skipping blank lines and lines
+// starting with '#'. Diceware lines such as "11111\tabacus" are accepted and
+// the dice index is dropped. It returns core.ErrEmptyItems if no words are
+// found and core.ErrInvalidWordlist if a word repeats, since duplicates
+// would silently reduce entropy.
+func ParseWordlist(r io.Reader) ([]string, error) {
+	var words []string
+	seen := map[string]bool{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		word := fields[len(fields)-1]
+		if seen[word] {
+			return nil, core.ErrInvalidWordlist
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, core.ErrEmptyItems
+	}
+	return words, nil
+}
@@ -0,0 +1,133 @@
+package randstring
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestDefaultWordlist(t *testing.T) {
+	words := DefaultWordlist()
+	if len(words) != 7772 {
+		t.Fatalf("DefaultWordlist has %d words want 7772", len(words))
+	}
+	lower := regexp.MustCompile(`^[a-z]+$`)
+	for _, w := range words {
+		if !lower.MatchString(w) {
+			t.Fatalf("DefaultWordlist word %q is not lower-case letters", w)
+		}
+	}
+	if words[0] != "abacus" || words[len(words)-1] != "zoom" {
+		t.Fatalf("DefaultWordlist spans %q..%q want abacus..zoom", words[0], words[len(words)-1])
+	}
+	words[0] = "mutated"
+	if DefaultWordlist()[0] == "mutated" {
+		t.Fatalf("DefaultWordlist returned shared storage")
+	}
+}
+
+func TestPassphraseShape(t *testing.T) {
+	inList := map[string]bool{}
+	for _, w := range DefaultWordlist() {
+		inList[w] = true
+	}
+	src, err := adapters.DeterministicSource([]byte("passphrase-shape"))
+	if err != nil {
+		if errors.Is(err, core.ErrDeterministicDisabled) {
+			t.Skip("deterministic sources disabled")
+		}
+		t.Fatalf("DeterministicSource error: %v", err)
+	}
+	gen := New(core.New(src))
+	p, err := gen.Passphrase(5)
+	if err != nil {
+		t.Fatalf("Passphrase error: %v", err)
+	}
+	parts := strings.Split(p, "-")
+	if len(parts) != 5 {
+		t.Fatalf("Passphrase %q has %d words want 5", p, len(parts))
+	}
+	for _, w := range parts {
+		if !inList[w] {
+			t.Fatalf("Passphrase word %q not in wordlist", w)
+		}
+	}
+
+	p, err = gen.Passphrase(3, WithSeparator(" "), WithCapitalize(), WithDigits(2))
+	if err != nil {
+		t.Fatalf("Passphrase error: %v", err)
+	}
+	parts = strings.Split(p, " ")
+	if len(parts) != 4 || len(parts[3]) != 2 || strings.Trim(parts[3], CharsetDigits) != "" {
+		t.Fatalf("Passphrase %q want 3 words and 2 digits", p)
+	}
+	for _, w := range parts[:3] {
+		if !unicode.IsUpper(rune(w[0])) {
+			t.Fatalf("word %q not capitalized", w)
+		}
+	}
+}
+
+func TestPassphraseCustomWordlist(t *testing.T) {
+	words, err := ParseWordlist(strings.NewReader("# EFF style\n11111\talpha\n11112\tbeta\n\nömega\n"))
+	if err != nil || len(words) != 3 {
+		t.Fatalf("ParseWordlist = %v err: %v", words, err)
+	}
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(2)))
+	p, err := gen.Passphrase(1, WithWordlist(words), WithCapitalize())
+	if err != nil || p != "Ömega" {
+		t.Fatalf("Passphrase = %q err: %v want Ömega", p, err)
+	}
+}
+
+func TestWithWordlistCopies(t *testing.T) {
+	words := []string{"alpha", "beta"}
+	opt := WithWordlist(words)
+	words[0], words[1] = "gamma", "gamma"
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0)))
+	p, err := gen.Passphrase(1, opt)
+	if err != nil || p != "alpha" {
+		t.Fatalf("Passphrase = %q err: %v want alpha", p, err)
+	}
+}
+
+func TestPassphraseEntropyBits(t *testing.T) {
+	bits, err := PassphraseEntropyBits(6, WithDigits(3))
+	want := 6*math.Log2(7772) + 3*math.Log2(10)
+	if err != nil || math.Abs(bits-want) > 1e-9 {
+		t.Fatalf("PassphraseEntropyBits = %v err: %v want %v", bits, err, want)
+	}
+}
+
+func TestPassphraseErrors(t *testing.T) {
+	if _, err := Passphrase(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("0 words err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := Passphrase(2, WithDigits(-1)); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative digits err=%v want ErrNegativeLength", err)
+	}
+	if _, err := Passphrase(2, WithWordlist([]string{})); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("empty wordlist err=%v want ErrEmptyItems", err)
+	}
+	for _, words := range [][]string{{"a", "b", "a"}, {"a", ""}} {
+		if _, err := Passphrase(2, WithWordlist(words)); !errors.Is(err, core.ErrInvalidWordlist) {
+			t.Fatalf("WithWordlist(%q) err=%v want ErrInvalidWordlist", words, err)
+		}
+		if _, err := PassphraseEntropyBits(2, WithWordlist(words)); !errors.Is(err, core.ErrInvalidWordlist) {
+			t.Fatalf("PassphraseEntropyBits(%q) err=%v want ErrInvalidWordlist", words, err)
+		}
+	}
+	if _, err := ParseWordlist(strings.NewReader("a\nb\na\n")); !errors.Is(err, core.ErrInvalidWordlist) {
+		t.Fatalf("duplicate words err=%v want ErrInvalidWordlist", err)
+	}
+	if _, err := ParseWordlist(strings.NewReader("\n# only comments\n")); !errors.Is(err, core.ErrEmptyItems) {
+		t.Fatalf("no words err=%v want ErrEmptyItems", err)
+	}
+}
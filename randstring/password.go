@@ -0,0 +1,153 @@
+package randstring
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Character classes used by Password.
+const (
+	passwordSymbols = "!#$%&*+-=?@^_~"
+	ambiguousChars  = "0O1lI|"
+)
+
+// PasswordOptions configures Password. Every enabled class must appear at
+// least once in the result.
+type PasswordOptions struct {
+	// Length is the number of characters; it must be at least the number of
+	// enabled classes.
+	Length int
+	// Upper, Lower, Digits and Symbols enable the matching character
+	// classes. If none is set, Upper, Lower and Digits are used.
+	Upper   bool
+	Lower   bool
+	Digits  bool
+	Symbols bool
+	// SymbolSet overrides the default symbol class "!#$%&*+-=?@^_~".
+	SymbolSet string
+	// ExcludeAmbiguous drops characters that are easy to confuse: 0 O 1 l I |.
+	ExcludeAmbiguous bool
+	// NoRepeat forbids any character from appearing more than once.
+	NoRepeat bool
+}
+
+// Password returns a random password satisfying opts. Candidates are drawn
+// uniformly from the combined alphabet and rejected until every enabled
+// class is present, so the result is uniform over all passwords that meet
+// the constraints.
+//
+// Parameters:
+//   - opts: The password policy.
+//
+// Returns:
+//   - string: A random password.
+//   - error: An error if the policy cannot be satisfied, a class is empty
+//     after exclusions, or if crypto/rand fails.
+func Password(opts PasswordOptions) (string, error) {
+	return Default().Password(opts)
+}
+
+// Password returns a random password satisfying opts using the generator's
+// entropy source.
+func (g *Generator) Password(opts PasswordOptions) (string, error) {
+	b, err := g.passwordBytes(opts)
+	if err != nil {
+		return "", err
+	}
+	defer core.Zero(b)
+	return string(b), nil
+}
+
+// passwordBytes implements Password. Rejected candidates are wiped.
+func (g *Generator) passwordBytes(opts PasswordOptions) ([]byte, error) {
+	classes, err := passwordClasses(opts)
+	if err != nil {
+		return nil, err
+	}
+	alphabet := strings.Join(classes, "")
+	if opts.Length < len(classes) || (opts.NoRepeat && opts.Length > len(alphabet)) {
+		return nil, &core.ArgError{Op: "Password", Arg: "Length", Value: opts.Length, Err: core.ErrUnsatisfiable}
+	}
+	for {
+		var candidate []byte
+		if opts.NoRepeat {
+			candidate, err = g.distinctChars(opts.Length, alphabet)
+		} else {
+			candidate, err = g.charsetBytes(opts.Length, alphabet)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hasEveryClass(candidate, classes) {
+			return candidate, nil
+		}
+		core.Zero(candidate)
+	}
+}
+
+func passwordClasses(opts PasswordOptions) ([]string, error) {
+	if !opts.Upper && !opts.Lower && !opts.Digits && !opts.Symbols {
+		opts.Upper, opts.Lower, opts.Digits = true, true, true
+	}
+	symbols := passwordSymbols
+	if opts.SymbolSet != "" {
+		symbols = opts.SymbolSet
+	}
+	var classes []string
+	seen := map[byte]bool{}
+	for _, c := range []struct {
+		on  bool
+		set string
+	}{{opts.Upper, CharsetUpper}, {opts.Lower, CharsetLower}, {opts.Digits, CharsetDigits}, {opts.Symbols, symbols}} {
+		if !c.on {
+			continue
+		}
+		if !isASCIICharset(c.set) {
+			return nil, core.ErrInvalidCharset
+		}
+		var b strings.Builder
+		for i := 0; i < len(c.set); i++ {
+			ch := c.set[i]
+			if seen[ch] || (opts.ExcludeAmbiguous && strings.IndexByte(ambiguousChars, ch) >= 0) {
+				continue
+			}
+			seen[ch] = true
+			b.WriteByte(ch)
+		}
+		if b.Len() == 0 {
+			return nil, core.ErrEmptyCharset
+		}
+		classes = append(classes, b.String())
+	}
+	return classes, nil
+}
+
+// distinctChars returns length distinct characters of alphabet via a partial
+// Fisher-Yates shuffle.
+func (g *Generator) distinctChars(length int, alphabet string) ([]byte, error) {
+	pool := []byte(alphabet)
+	for i := 0; i < length; i++ {
+		// #nosec G115 -- len(pool)-i is positive.
+		j, err := g.rng.Uint64n(uint64(len(pool) - i))
+		if err != nil {
+			core.Zero(pool)
+			return nil, err
+		}
+		// #nosec G115 -- j < len(pool)-i.
+		k := i + int(j)
+		pool[i], pool[k] = pool[k], pool[i]
+	}
+	core.Zero(pool[length:])
+	return pool[:length:length], nil
+}
+
+func hasEveryClass(b []byte, classes []string) bool {
+	for _, class := range classes {
+		if !bytes.ContainsAny(b, class) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,22 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randstring
+
+// MustPassword returns a random password satisfying opts. It panics on error.
+func MustPassword(opts PasswordOptions) string {
+	s, err := Password(opts)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustPassphrase returns a diceware-style passphrase. It panics on error.
+func MustPassphrase(words int, opts ...PassphraseOption) string {
+	s, err := Passphrase(words, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
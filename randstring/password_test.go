@@ -0,0 +1,72 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestPasswordClassesPresent(t *testing.T) {
+	opts := PasswordOptions{Length: 4, Upper: true, Lower: true, Digits: true, Symbols: true}
+	for i := 0; i < 200; i++ {
+		p, err := Password(opts)
+		if err != nil || len(p) != 4 {
+			t.Fatalf("Password = %q err: %v", p, err)
+		}
+		for _, class := range []string{CharsetUpper, CharsetLower, CharsetDigits, passwordSymbols} {
+			if !strings.ContainsAny(p, class) {
+				t.Fatalf("Password %q lacks a character from %q", p, class)
+			}
+		}
+	}
+}
+
+func TestPasswordExclusionsAndNoRepeat(t *testing.T) {
+	opts := PasswordOptions{Length: 30, Digits: true, Upper: true, ExcludeAmbiguous: true, NoRepeat: true}
+	for i := 0; i < 100; i++ {
+		p, err := Password(opts)
+		if err != nil {
+			t.Fatalf("Password error: %v", err)
+		}
+		if strings.ContainsAny(p, ambiguousChars) {
+			t.Fatalf("Password %q contains an ambiguous character", p)
+		}
+		seen := map[rune]bool{}
+		for _, r := range p {
+			if seen[r] {
+				t.Fatalf("Password %q repeats %q", p, r)
+			}
+			seen[r] = true
+		}
+	}
+}
+
+func TestPasswordDefaultsAndSymbolSet(t *testing.T) {
+	p, err := Password(PasswordOptions{Length: 12})
+	if err != nil || len(p) != 12 || strings.ContainsAny(p, passwordSymbols) {
+		t.Fatalf("default Password = %q err: %v", p, err)
+	}
+	p, err = Password(PasswordOptions{Length: 6, Symbols: true, SymbolSet: "*"})
+	if err != nil || p != "******" {
+		t.Fatalf("symbol-only Password = %q err: %v", p, err)
+	}
+}
+
+func TestPasswordUnsatisfiable(t *testing.T) {
+	cases := []struct {
+		opts PasswordOptions
+		want error
+	}{
+		{PasswordOptions{Length: 2, Upper: true, Lower: true, Digits: true}, core.ErrUnsatisfiable},
+		{PasswordOptions{Length: 11, Digits: true, NoRepeat: true}, core.ErrUnsatisfiable},
+		{PasswordOptions{Length: 4, Symbols: true, SymbolSet: "0", Digits: true}, core.ErrEmptyCharset},
+		{PasswordOptions{Length: 4, Symbols: true, SymbolSet: "é"}, core.ErrInvalidCharset},
+	}
+	for _, tc := range cases {
+		if _, err := Password(tc.opts); !errors.Is(err, tc.want) {
+			t.Fatalf("Password(%+v) err=%v want %v", tc.opts, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package randstring
+
+import (
+	"strings"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// patternEscape makes the following pattern character a literal.
+const patternEscape = '\\'
+
+// patternClasses maps Pattern placeholders to their character classes.
+var patternClasses = map[rune]string{
+	'A': CharsetUpper,
+	'a': CharsetLower,
+	'#': CharsetDigits,
+	'?': CharsetUpper + CharsetLower + CharsetDigits,
+}
+
+// Pattern returns a random string shaped by pattern, for license keys, SKUs
+// and coupon codes. Placeholders are replaced by one random character each:
+// A (upper-case letter), a (lower-case letter), # (digit) and ? (letter or
+// digit). Every other character is copied through; prefix a placeholder or
+// backslash with a backslash to emit it literally.
+//
+// Parameters:
+//   - pattern: The template, e.g. "AA-####-aaaa".
+//
+// Returns:
+//   - string: A random string matching pattern.
+//   - error: An error if pattern ends with a lone backslash or if crypto/rand
+//     fails.
+func Pattern(pattern string) (string, error) {
+	return Default().Pattern(pattern)
+}
+
+// Pattern returns a random string shaped by pattern using the generator's
+// entropy source.
+func (g *Generator) Pattern(pattern string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(pattern))
+	escaped := false
+	for _, r := range pattern {
+		if escaped {
+			b.WriteRune(r)
+			escaped = false
+			continue
+		}
+		if r == patternEscape {
+			escaped = true
+			continue
+		}
+		class, ok := patternClasses[r]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		idx, err := g.rng.Uint64n(uint64(len(class)))
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(class[idx])
+	}
+	if escaped {
+		return "", &core.ArgError{Op: "Pattern", Arg: "pattern", Value: pattern, Err: core.ErrInvalidPattern}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,13 @@
+//go:build randutil_must
+// +build randutil_must
+
+package randstring
+
+// MustPattern returns a random string shaped by pattern. It panics on error.
+func MustPattern(pattern string) string {
+	s, err := Pattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package randstring
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestPatternShape(t *testing.T) {
+	re := regexp.MustCompile(`^[A-Z]{2}-[0-9]{4}-[a-z]{4}/[A-Za-z0-9]$`)
+	for i := 0; i < 50; i++ {
+		s, err := Pattern("AA-####-aaaa/?")
+		if err != nil {
+			t.Fatalf("Pattern error: %v", err)
+		}
+		if !re.MatchString(s) {
+			t.Fatalf("Pattern = %q does not match %s", s, re)
+		}
+	}
+}
+
+func TestPatternEscapesAndLiterals(t *testing.T) {
+	s, err := Pattern(`SKU\#\A\\é`)
+	if err != nil || s != `SKU#A\é` {
+		t.Fatalf("Pattern = %q err: %v want %q", s, err, `SKU#A\é`)
+	}
+	if s, err := Pattern(""); err != nil || s != "" {
+		t.Fatalf("Pattern(\"\") = %q err: %v", s, err)
+	}
+}
+
+var errTest = errors.New("boom")
+
+func TestPatternErrors(t *testing.T) {
+	if _, err := Pattern(`AA\`); !errors.Is(err, core.ErrInvalidPattern) {
+		t.Fatalf("trailing escape err=%v want ErrInvalidPattern", err)
+	}
+	gen := NewWithSource(testutil.ErrReader{Err: errTest})
+
+	if _, err := gen.Pattern("A"); !errors.Is(err, errTest) {
+		t.Fatalf("entropy err=%v want %v", err, errTest)
+	}
+}
@@ -0,0 +1,48 @@
+package randstring
+
+import "github.com/aatuh/randutil/v2/core"
+
+// PIN returns a numeric PIN of digits decimal digits. Every digit is drawn
+// uniformly by rejection sampling and leading zeros are kept, so all
+// 10^digits values are equally likely, unlike formatting a modulo-reduced
+// integer.
+//
+// Parameters:
+//   - digits: The number of digits.
+//
+// Returns:
+//   - string: The PIN.
+//   - error: An error if digits <= 0 or if crypto/rand fails.
+func PIN(digits int) (string, error) {
+	return Default().PIN(digits)
+}
+
+// OTP returns a numeric one-time code of digits decimal digits with the same
+// uniformity guarantees as PIN, e.g. for codes sent by e-mail or SMS.
+//
+// Parameters:
+//   - digits: The number of digits; 6 to 8 is typical.
+//
+// Returns:
+//   - string: The one-time code.
+//   - error: An error if digits <= 0 or if crypto/rand fails.
+func OTP(digits int) (string, error) {
+	return Default().OTP(digits)
+}
+
+// PIN returns a numeric PIN using the generator's entropy source.
+func (g *Generator) PIN(digits int) (string, error) {
+	return g.digitString("PIN", digits)
+}
+
+// OTP returns a numeric one-time code using the generator's entropy source.
+func (g *Generator) OTP(digits int) (string, error) {
+	return g.digitString("OTP", digits)
+}
+
+func (g *Generator) digitString(op string, digits int) (string, error) {
+	if digits <= 0 {
+		return "", &core.ArgError{Op: op, Arg: "digits", Value: digits, Err: core.ErrNonPositiveBound}
+	}
+	return g.StringWithCharset(digits, CharsetDigits)
+}
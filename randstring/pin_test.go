@@ -0,0 +1,51 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestPINAndOTP(t *testing.T) {
+	for _, gen := range []func(int) (string, error){PIN, OTP} {
+		s, err := gen(6)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		if len(s) != 6 || strings.Trim(s, CharsetDigits) != "" {
+			t.Fatalf("code = %q", s)
+		}
+		if _, err := gen(0); !errors.Is(err, core.ErrNonPositiveBound) {
+			t.Fatalf("zero digits err=%v", err)
+		}
+	}
+}
+
+func TestPINKeepsLeadingZerosAndRejectsBias(t *testing.T) {
+	// Bytes >= 250 would bias a modulo reduction and must be skipped.
+	raw := make([]byte, 128)
+	copy(raw, []byte{255, 250, 0, 17})
+	s, err := NewWithSource(testutil.NewSeqReader(raw)).PIN(3)
+	if err != nil || s != "070" {
+		t.Fatalf("PIN = %q err: %v want 070", s, err)
+	}
+}
+
+func TestPINUniformDigits(t *testing.T) {
+	s, err := PIN(20000)
+	if err != nil {
+		t.Fatalf("PIN error: %v", err)
+	}
+	var counts [10]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]-'0']++
+	}
+	for d, c := range counts {
+		if c < 1700 || c > 2300 {
+			t.Fatalf("digit %d appeared %d times in 20000", d, c)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package randstring
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestStringWithRunes(t *testing.T) {
+	alphabet := []rune("äö😀漢")
+	allowed := map[rune]bool{}
+	for _, r := range alphabet {
+		allowed[r] = true
+	}
+	s, err := StringWithRunes(200, alphabet)
+	if err != nil {
+		t.Fatalf("StringWithRunes error: %v", err)
+	}
+	if !utf8.ValidString(s) || utf8.RuneCountInString(s) != 200 {
+		t.Fatalf("StringWithRunes returned %d runes, valid=%v", utf8.RuneCountInString(s), utf8.ValidString(s))
+	}
+	seen := map[rune]bool{}
+	for _, r := range s {
+		if !allowed[r] {
+			t.Fatalf("unexpected rune %q", r)
+		}
+		seen[r] = true
+	}
+	if len(seen) != len(alphabet) {
+		t.Fatalf("saw %d distinct runes want %d", len(seen), len(alphabet))
+	}
+}
+
+func TestStringWithRunesDeterministic(t *testing.T) {
+	gen := NewWithSource(testutil.NewSeqReader(testutil.Uint64Bytes(0), testutil.Uint64Bytes(3), testutil.Uint64Bytes(1)))
+	s, err := gen.StringWithRunes(3, []rune("αβγδ"))
+	if err != nil || s != "αδβ" {
+		t.Fatalf("StringWithRunes = %q err: %v want αδβ", s, err)
+	}
+}
+
+func TestStringWithRunesErrors(t *testing.T) {
+	if _, err := StringWithRunes(-1, []rune("a")); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative length err=%v", err)
+	}
+	if _, err := StringWithRunes(1, nil); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("empty runes err=%v", err)
+	}
+	if _, err := StringWithRunes(1, []rune{0xD800}); !errors.Is(err, core.ErrInvalidRune) {
+		t.Fatalf("surrogate err=%v", err)
+	}
+	if _, err := StringWithRunes(math.MaxInt, []rune("a")); !errors.Is(err, core.ErrResultOutOfRange) {
+		t.Fatalf("overflowing length err=%v", err)
+	}
+}
+
+func TestRunePresets(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []rune
+		want int
+	}{
+		{"Greek", GreekRunes(), 48},
+		{"Cyrillic", CyrillicRunes(), 64},
+		{"Emoji", EmojiRunes(), 80},
+		{"CJK", CJKRunes(), 20992},
+	}
+	for _, tt := range tests {
+		if len(tt.got) != tt.want {
+			t.Fatalf("%s preset has %d runes want %d", tt.name, len(tt.got), tt.want)
+		}
+		seen := map[rune]bool{}
+		for _, r := range tt.got {
+			if !utf8.ValidRune(r) || seen[r] {
+				t.Fatalf("%s preset has invalid or duplicate rune %U", tt.name, r)
+			}
+			seen[r] = true
+		}
+	}
+	if g := GreekRunes(); g[0] != 'Α' || g[len(g)-1] != 'ω' {
+		t.Fatalf("Greek preset bounds = %q..%q", g[0], g[len(g)-1])
+	}
+}
@@ -0,0 +1,113 @@
+package randstring
+
+import (
+	"crypto/subtle"
+	"sync"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// redacted is what a Secret prints as.
+const redacted = "[REDACTED]"
+
+// Secret holds generated credential bytes that can be wiped explicitly,
+// unlike an immutable Go string. It formats as "[REDACTED]" so it is not
+// leaked by logging. To move the bytes into a locked buffer such as a
+// memguard enclave, pass Bytes to the buffer constructor and then call Wipe.
+//
+// Concurrency: safe for concurrent use; Bytes returns the shared backing
+// slice, which Wipe zeroes.
+type Secret struct {
+	mu sync.Mutex
+	b  []byte
+}
+
+// NewSecret returns a Secret that takes ownership of b. The caller must not
+// use b afterwards except through the Secret.
+func NewSecret(b []byte) *Secret {
+	return &Secret{b: b}
+}
+
+// Bytes returns the backing slice. It is nil after Wipe.
+func (s *Secret) Bytes() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.b
+}
+
+// Len returns the number of secret bytes, or 0 after Wipe.
+func (s *Secret) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.b)
+}
+
+// Equal reports in constant time whether the secret equals other.
+func (s *Secret) Equal(other []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.b != nil && subtle.ConstantTimeCompare(s.b, other) == 1
+}
+
+// Wipe zeroes the backing bytes and releases them. It is idempotent.
+func (s *Secret) Wipe() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	core.Zero(s.b)
+	s.b = nil
+}
+
+// String implements fmt.Stringer without revealing the secret.
+func (s *Secret) String() string {
+	return redacted
+}
+
+// GoString implements fmt.GoStringer without revealing the secret.
+func (s *Secret) GoString() string {
+	return redacted
+}
+
+// PasswordSecret returns a password satisfying opts as a wipeable Secret.
+//
+// Parameters:
+//   - opts: The password policy.
+//
+// Returns:
+//   - *Secret: The password bytes.
+//   - error: An error under the same conditions as Password.
+func PasswordSecret(opts PasswordOptions) (*Secret, error) {
+	return Default().PasswordSecret(opts)
+}
+
+// TokenSecret returns a URL-safe base64 token without padding encoding
+// nBytes of random data as a wipeable Secret.
+//
+// Parameters:
+//   - nBytes: The length of the random bytes.
+//
+// Returns:
+//   - *Secret: The encoded token bytes.
+//   - error: An error if nBytes < 0 or if crypto/rand fails.
+func TokenSecret(nBytes int) (*Secret, error) {
+	return Default().TokenSecret(nBytes)
+}
+
+// PasswordSecret returns a password satisfying opts as a wipeable Secret
+// using the generator's entropy source.
+func (g *Generator) PasswordSecret(opts PasswordOptions) (*Secret, error) {
+	b, err := g.passwordBytes(opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewSecret(b), nil
+}
+
+// TokenSecret returns a URL-safe base64 token as a wipeable Secret using the
+// generator's entropy source.
+func (g *Generator) TokenSecret(nBytes int) (*Secret, error) {
+	b, err := g.TokenURLSafeBytes(nBytes)
+	if err != nil {
+		return nil, err
+	}
+	return NewSecret(b), nil
+}
@@ -0,0 +1,65 @@
+package randstring
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestSecretWipe(t *testing.T) {
+	b := []byte("hunter2")
+	s := NewSecret(b)
+	if s.Len() != 7 || !s.Equal([]byte("hunter2")) || s.Equal([]byte("hunter3")) {
+		t.Fatalf("Secret does not hold its bytes")
+	}
+	s.Wipe()
+	for i, c := range b {
+		if c != 0 {
+			t.Fatalf("byte %d not wiped: %q", i, c)
+		}
+	}
+	if s.Bytes() != nil || s.Len() != 0 || s.Equal(nil) {
+		t.Fatalf("Secret still exposes data after Wipe")
+	}
+	s.Wipe()
+}
+
+func TestSecretRedactsFormatting(t *testing.T) {
+	s := NewSecret([]byte("hunter2"))
+	for _, verb := range []string{"%v", "%s", "%+v", "%#v"} {
+		if got := fmt.Sprintf(verb, s); strings.Contains(got, "hunter2") {
+			t.Fatalf("%s leaked secret: %q", verb, got)
+		}
+	}
+}
+
+func TestPasswordSecret(t *testing.T) {
+	s, err := PasswordSecret(PasswordOptions{Length: 16, Upper: true, Digits: true})
+	if err != nil {
+		t.Fatalf("PasswordSecret error: %v", err)
+	}
+	defer s.Wipe()
+	if s.Len() != 16 || strings.Trim(string(s.Bytes()), CharsetUpper+CharsetDigits) != "" {
+		t.Fatalf("PasswordSecret = %q", s.Bytes())
+	}
+	if cap(s.Bytes()) != 16 {
+		t.Fatalf("PasswordSecret capacity %d exposes unwiped bytes", cap(s.Bytes()))
+	}
+}
+
+func TestTokenSecret(t *testing.T) {
+	raw := []byte{0xde, 0xad, 0xbe, 0xef}
+	s, err := NewWithSource(testutil.NewSeqReader(raw)).TokenSecret(len(raw))
+	if err != nil {
+		t.Fatalf("TokenSecret error: %v", err)
+	}
+	if want := base64.RawURLEncoding.EncodeToString(raw); !s.Equal([]byte(want)) {
+		t.Fatalf("TokenSecret = %q want %q", s.Bytes(), want)
+	}
+	if _, err := TokenSecret(-1); err == nil {
+		t.Fatalf("expected error for negative length")
+	}
+}
@@ -0,0 +1,81 @@
+package randstring
+
+import "github.com/aatuh/randutil/v2/core"
+
+// reservedWords lists the lower-case Go keywords and JavaScript reserved
+// words, which Identifier never returns.
+var reservedWords = map[string]bool{
+	"await": true, "break": true, "case": true, "catch": true, "chan": true,
+	"class": true, "const": true, "continue": true, "debugger": true,
+	"default": true, "defer": true, "delete": true, "do": true, "else": true,
+	"enum": true, "export": true, "extends": true, "fallthrough": true,
+	"false": true, "finally": true, "for": true, "func": true, "function": true,
+	"go": true, "goto": true, "if": true, "implements": true, "import": true,
+	"in": true, "instanceof": true, "interface": true, "let": true, "map": true,
+	"new": true, "null": true, "package": true, "private": true,
+	"protected": true, "public": true, "range": true, "return": true,
+	"select": true, "static": true, "struct": true, "super": true,
+	"switch": true, "this": true, "throw": true, "true": true, "try": true,
+	"type": true, "typeof": true, "var": true, "void": true, "while": true,
+	"with": true, "yield": true,
+}
+
+// Slug returns words lower-case words from the default wordlist joined by
+// hyphens, e.g. "brave-emerald-falcon", for readable resource names.
+//
+// Parameters:
+//   - words: The number of words.
+//
+// Returns:
+//   - string: The slug.
+//   - error: An error if words <= 0 or if crypto/rand fails.
+func Slug(words int) (string, error) {
+	return Default().Slug(words)
+}
+
+// Identifier returns a random lower-case identifier of length characters
+// that starts with a letter and continues with letters and digits. It is a
+// valid Go and JavaScript identifier, never a keyword, and a valid DNS
+// label for lengths up to 63.
+//
+// Parameters:
+//   - length: The identifier length.
+//
+// Returns:
+//   - string: The identifier.
+//   - error: An error if length <= 0 or if crypto/rand fails.
+func Identifier(length int) (string, error) {
+	return Default().Identifier(length)
+}
+
+// Slug returns a hyphenated slug of words words using the generator's
+// entropy source.
+func (g *Generator) Slug(words int) (string, error) {
+	if words <= 0 {
+		return "", &core.ArgError{Op: "Slug", Arg: "words", Value: words, Err: core.ErrNonPositiveBound}
+	}
+	// The default wordlist holds only lower-case ASCII words, so the
+	// result splits back into exactly its words.
+	return g.Passphrase(words)
+}
+
+// Identifier returns a random identifier using the generator's entropy
+// source.
+func (g *Generator) Identifier(length int) (string, error) {
+	if length <= 0 {
+		return "", &core.ArgError{Op: "Identifier", Arg: "length", Value: length, Err: core.ErrNonPositiveBound}
+	}
+	for {
+		first, err := g.StringWithCharset(1, CharsetLower)
+		if err != nil {
+			return "", err
+		}
+		rest, err := g.StringWithCharset(length-1, CharsetLower+CharsetDigits)
+		if err != nil {
+			return "", err
+		}
+		if id := first + rest; !reservedWords[id] {
+			return id, nil
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package randstring
+
+import (
+	"bytes"
+	"errors"
+	"go/token"
+	"regexp"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestSlug(t *testing.T) {
+	src, err := adapters.DeterministicSource([]byte("slug"))
+	if err != nil {
+		if errors.Is(err, core.ErrDeterministicDisabled) {
+			t.Skip("deterministic sources disabled")
+		}
+		t.Fatalf("DeterministicSource error: %v", err)
+	}
+	s, err := New(core.New(src)).Slug(3)
+	if err != nil {
+		t.Fatalf("Slug error: %v", err)
+	}
+	if !regexp.MustCompile(`^[a-z]+-[a-z]+-[a-z]+$`).MatchString(s) {
+		t.Fatalf("Slug = %q", s)
+	}
+	if _, err := Slug(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("zero words err=%v", err)
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	re := regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	for _, n := range []int{1, 2, 8, 63} {
+		id, err := Identifier(n)
+		if err != nil {
+			t.Fatalf("Identifier error: %v", err)
+		}
+		if len(id) != n || !re.MatchString(id) || !token.IsIdentifier(id) {
+			t.Fatalf("Identifier(%d) = %q", n, id)
+		}
+	}
+	if _, err := Identifier(0); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("zero length err=%v", err)
+	}
+}
+
+func TestIdentifierSkipsKeywords(t *testing.T) {
+	// The first draw spells "if" (i=8, f=5); the second spells "ab".
+	var chunks [][]byte
+	for _, v := range []byte{8, 5, 0, 1} {
+		chunks = append(chunks, bytes.Repeat([]byte{v}, 128))
+	}
+	gen := NewWithSource(testutil.NewSeqReader(chunks...))
+	id, err := gen.Identifier(2)
+	if err != nil {
+		t.Fatalf("Identifier error: %v", err)
+	}
+	if token.IsKeyword(id) || reservedWords[id] {
+		t.Fatalf("Identifier returned keyword %q", id)
+	}
+	if id != "ab" {
+		t.Fatalf("Identifier = %q want ab", id)
+	}
+}
+
+func TestReservedWordsCoverGoKeywords(t *testing.T) {
+	for _, kw := range []string{"break", "case", "chan", "const", "continue", "default",
+		"defer", "else", "fallthrough", "for", "func", "go", "goto", "if", "import",
+		"interface", "map", "package", "range", "return", "select", "struct",
+		"switch", "type", "var"} {
+		if !token.IsKeyword(kw) || !reservedWords[kw] {
+			t.Fatalf("keyword %q missing", kw)
+		}
+	}
+}
@@ -61,3 +61,44 @@ func TokenURLSafe(nBytes int) (string, error) {
 func TokenURLSafeBytes(nBytes int) ([]byte, error) {
 	return Default().TokenURLSafeBytes(nBytes)
 }
+
+// TokenBase58 returns a Bitcoin-style base58 string encoding nBytes of
+// random data.
+// Note: strings are immutable; use TokenBase58Bytes if you need to wipe secrets.
+//
+// Parameters:
+//   - nBytes: The length of the random bytes.
+//
+// Returns:
+//   - string: A base58 string; leading zero bytes encode as '1'.
+//   - error: An error if crypto/rand fails.
+func TokenBase58(nBytes int) (string, error) {
+	return Default().TokenBase58(nBytes)
+}
+
+// TokenBase58Bytes returns a base58 token as a byte slice.
+// Callers may zero the returned slice after use.
+func TokenBase58Bytes(nBytes int) ([]byte, error) {
+	return Default().TokenBase58Bytes(nBytes)
+}
+
+// TokenBase32Crockford returns an unpadded Crockford base32 string encoding
+// nBytes of random data.
+// Note: strings are immutable; use TokenBase32CrockfordBytes if you need to
+// wipe secrets.
+//
+// Parameters:
+//   - nBytes: The length of the random bytes.
+//
+// Returns:
+//   - string: A Crockford base32 string of length ceil(8*nBytes/5).
+//   - error: An error if crypto/rand fails.
+func TokenBase32Crockford(nBytes int) (string, error) {
+	return Default().TokenBase32Crockford(nBytes)
+}
+
+// TokenBase32CrockfordBytes returns a Crockford base32 token as a byte slice.
+// Callers may zero the returned slice after use.
+func TokenBase32CrockfordBytes(nBytes int) ([]byte, error) {
+	return Default().TokenBase32CrockfordBytes(nBytes)
+}
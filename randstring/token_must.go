@@ -80,3 +80,40 @@ func MustTokenURLSafeBytes(nBytes int) []byte {
 	}
 	return b
 }
+
+// MustTokenBase58 returns a base58 string encoding nBytes of random data. It panics on error.
+func MustTokenBase58(nBytes int) string {
+	s, err := TokenBase58(nBytes)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustTokenBase58Bytes returns a base58 token as a byte slice. It panics on error.
+func MustTokenBase58Bytes(nBytes int) []byte {
+	b, err := TokenBase58Bytes(nBytes)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// MustTokenBase32Crockford returns an unpadded Crockford base32 string encoding
+// nBytes of random data. It panics on error.
+func MustTokenBase32Crockford(nBytes int) string {
+	s, err := TokenBase32Crockford(nBytes)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustTokenBase32CrockfordBytes returns a Crockford base32 token as a byte slice. It panics on error.
+func MustTokenBase32CrockfordBytes(nBytes int) []byte {
+	b, err := TokenBase32CrockfordBytes(nBytes)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,114 @@
+package randstring
+
+import (
+	"math/bits"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// UniqueStrings returns count distinct random strings of length characters
+// drawn from charset, for seeding databases with unique codes. Repeated
+// characters in charset are ignored. When count is small relative to the
+// number of possible strings, collisions are simply redrawn; otherwise the
+// strings are chosen as distinct indices into the space, so the call stays
+// fast even when count equals the whole space.
+//
+// Parameters:
+//   - count: The number of strings to generate.
+//   - length: The length of each string.
+//   - charset: The ASCII charset to draw from.
+//
+// Returns:
+//   - []string: count distinct strings in random order.
+//   - error: An error if count or length is negative, charset is invalid,
+//     count exceeds len(charset)^length, or if crypto/rand fails.
+func UniqueStrings(count, length int, charset string) ([]string, error) {
+	return Default().UniqueStrings(count, length, charset)
+}
+
+// UniqueStrings returns count distinct random strings using the generator's
+// entropy source.
+func (g *Generator) UniqueStrings(count, length int, charset string) ([]string, error) {
+	if count < 0 || length < 0 {
+		return nil, core.ErrNegativeLength
+	}
+	if err := checkCharset(charset); err != nil {
+		return nil, err
+	}
+	alphabet := CombineCharsets(charset)
+	space, fits := stringSpace(len(alphabet), length)
+	// #nosec G115 -- count is non-negative.
+	if fits && uint64(count) > space {
+		return nil, &core.ArgError{Op: "UniqueStrings", Arg: "count", Value: count, Err: core.ErrUnsatisfiable}
+	}
+	out := make([]string, 0, count)
+	// #nosec G115 -- count is non-negative.
+	if fits && uint64(count) > space/2 {
+		return g.uniqueByIndex(out, count, length, alphabet, space)
+	}
+	seen := make(map[string]struct{}, count)
+	buf := make([]byte, length)
+	for len(out) < count {
+		if err := g.fillCharset(buf, alphabet); err != nil {
+			return nil, err
+		}
+		if _, dup := seen[string(buf)]; dup {
+			continue
+		}
+		s := string(buf)
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// uniqueByIndex picks count distinct indices in [0, space) with Floyd's
+// algorithm, shuffles them and renders each in base len(alphabet).
+func (g *Generator) uniqueByIndex(out []string, count, length int, alphabet string, space uint64) ([]string, error) {
+	chosen := make(map[uint64]struct{}, count)
+	idx := make([]uint64, 0, count)
+	// #nosec G115 -- count <= space.
+	for j := space - uint64(count); j < space; j++ {
+		t, err := g.rng.Uint64n(j + 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := chosen[t]; ok {
+			t = j
+		}
+		chosen[t] = struct{}{}
+		idx = append(idx, t)
+	}
+	for i := len(idx) - 1; i > 0; i-- {
+		// #nosec G115 -- i is positive.
+		j, err := g.rng.Uint64n(uint64(i + 1))
+		if err != nil {
+			return nil, err
+		}
+		idx[i], idx[j] = idx[j], idx[i]
+	}
+	base := uint64(len(alphabet))
+	buf := make([]byte, length)
+	for _, v := range idx {
+		for i := length - 1; i >= 0; i-- {
+			buf[i] = alphabet[v%base]
+			v /= base
+		}
+		out = append(out, string(buf))
+	}
+	return out, nil
+}
+
+// stringSpace returns base^length and whether it fits in a uint64.
+func stringSpace(base, length int) (uint64, bool) {
+	space := uint64(1)
+	for i := 0; i < length; i++ {
+		// #nosec G115 -- base is a positive charset length.
+		hi, lo := bits.Mul64(space, uint64(base))
+		if hi != 0 {
+			return 0, false
+		}
+		space = lo
+	}
+	return space, true
+}
@@ -0,0 +1,70 @@
+package randstring
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestUniqueStrings(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		length  int
+		charset string
+	}{
+		{"sparse", 500, 8, CharsetBase32Crockford},
+		{"dense", 90, 2, CharsetDigits},
+		{"exhaustive", 100, 2, CharsetDigits},
+		{"duplicate charset", 16, 4, "abab"},
+		{"empty", 0, 3, "ab"},
+		{"zero length", 1, 0, "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UniqueStrings(tt.count, tt.length, tt.charset)
+			if err != nil {
+				t.Fatalf("UniqueStrings error: %v", err)
+			}
+			if len(got) != tt.count {
+				t.Fatalf("got %d strings want %d", len(got), tt.count)
+			}
+			seen := map[string]bool{}
+			for _, s := range got {
+				if len(s) != tt.length || strings.Trim(s, tt.charset) != "" {
+					t.Fatalf("invalid string %q", s)
+				}
+				if seen[s] {
+					t.Fatalf("duplicate string %q", s)
+				}
+				seen[s] = true
+			}
+		})
+	}
+}
+
+func TestUniqueStringsErrors(t *testing.T) {
+	if _, err := UniqueStrings(101, 2, CharsetDigits); !errors.Is(err, core.ErrUnsatisfiable) {
+		t.Fatalf("infeasible count err=%v", err)
+	}
+	if _, err := UniqueStrings(5, 3, "aaa"); !errors.Is(err, core.ErrUnsatisfiable) {
+		t.Fatalf("duplicate-only charset err=%v", err)
+	}
+	if _, err := UniqueStrings(-1, 2, "ab"); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative count err=%v", err)
+	}
+	if _, err := UniqueStrings(1, 2, ""); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("empty charset err=%v", err)
+	}
+}
+
+func TestStringSpace(t *testing.T) {
+	if s, ok := stringSpace(10, 3); !ok || s != 1000 {
+		t.Fatalf("stringSpace(10, 3) = %d, %v", s, ok)
+	}
+	if _, ok := stringSpace(62, 20); ok {
+		t.Fatalf("stringSpace(62, 20) should overflow")
+	}
+}
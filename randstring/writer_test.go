@@ -0,0 +1,70 @@
+package randstring
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestWriterWriteN(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, CharsetHex)
+	n := writerChunkSize*2 + 17
+	if err := w.WriteN(n); err != nil {
+		t.Fatalf("WriteN error: %v", err)
+	}
+	if err := w.WriteN(3); err != nil {
+		t.Fatalf("WriteN error: %v", err)
+	}
+	if buf.Len() != n+3 || w.Written() != int64(n+3) {
+		t.Fatalf("wrote %d bytes, Written=%d want %d", buf.Len(), w.Written(), n+3)
+	}
+	if strings.Trim(buf.String(), CharsetHex) != "" {
+		t.Fatalf("output contains characters outside the charset")
+	}
+}
+
+type countingWriter struct{ writes int }
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.writes++
+	return len(p), nil
+}
+
+func TestWriterGrowsChunkAfterSmallWrite(t *testing.T) {
+	cw := &countingWriter{}
+	w := NewWriter(cw, CharsetHex)
+	if err := w.WriteN(1); err != nil {
+		t.Fatalf("WriteN error: %v", err)
+	}
+	if err := w.WriteN(writerChunkSize); err != nil {
+		t.Fatalf("WriteN error: %v", err)
+	}
+	if cw.writes != 2 {
+		t.Fatalf("writes = %d want 2 (one full chunk after a small write)", cw.writes)
+	}
+}
+
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) { return len(p) / 2, nil }
+
+func TestWriterErrors(t *testing.T) {
+	if err := NewWriter(io.Discard, "").WriteN(1); !errors.Is(err, core.ErrEmptyCharset) {
+		t.Fatalf("empty charset err=%v", err)
+	}
+	if err := NewWriter(io.Discard, "é").WriteN(1); !errors.Is(err, core.ErrInvalidCharset) {
+		t.Fatalf("non-ASCII charset err=%v", err)
+	}
+	if err := NewWriter(io.Discard, "ab").WriteN(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative n err=%v", err)
+	}
+	w := NewWriter(shortWriter{}, "ab")
+	if err := w.WriteN(10); !errors.Is(err, io.ErrShortWrite) || w.Written() != 5 {
+		t.Fatalf("short write err=%v written=%d", err, w.Written())
+	}
+}
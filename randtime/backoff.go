@@ -0,0 +1,126 @@
+package randtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// JitterStrategy selects how Backoff randomizes its exponential delays.
+type JitterStrategy int
+
+// Supported jitter strategies, as described in the AWS Architecture Blog
+// post "Exponential Backoff And Jitter".
+const (
+	// JitterFull draws uniformly from [0, d], where d is the capped
+	// exponential delay. It spreads retries the most.
+	JitterFull JitterStrategy = iota
+	// JitterEqual draws uniformly from [d/2, d], keeping a guaranteed
+	// minimum wait.
+	JitterEqual
+	// JitterDecorrelated draws uniformly from [Base, 3*previous], capped at
+	// Max, so each delay depends on the last one instead of the attempt.
+	JitterDecorrelated
+)
+
+// Backoff computes randomized exponential backoff delays.
+//
+// Concurrency: safe for concurrent use; JitterDecorrelated state is shared
+// by all callers, so use one Backoff per retry loop.
+type Backoff struct {
+	gen      *Generator
+	base     time.Duration
+	max      time.Duration
+	strategy JitterStrategy
+
+	mu   sync.Mutex
+	prev time.Duration
+}
+
+// NewBackoff returns a Backoff using the default generator.
+//
+// Parameters:
+//   - base: The first delay before jitter; must be > 0.
+//   - maxDelay: The cap on any delay; must be >= base.
+//   - strategy: How delays are randomized.
+//
+// Returns:
+//   - *Backoff: A new backoff policy.
+//   - error: ErrNonPositiveBound, ErrMinGreaterThanMax or
+//     ErrUnknownJitterStrategy for bad arguments.
+func NewBackoff(base, maxDelay time.Duration, strategy JitterStrategy) (*Backoff, error) {
+	return Default().NewBackoff(base, maxDelay, strategy)
+}
+
+// NewBackoff returns a Backoff drawing its jitter from g.
+func (g *Generator) NewBackoff(base, maxDelay time.Duration, strategy JitterStrategy) (*Backoff, error) {
+	if base <= 0 {
+		return nil, &core.ArgError{Op: "NewBackoff", Arg: "base", Value: base, Err: core.ErrNonPositiveBound}
+	}
+	if maxDelay < base {
+		return nil, &core.RangeError{Op: "NewBackoff", Min: base, Max: maxDelay, Err: core.ErrMinGreaterThanMax}
+	}
+	if strategy < JitterFull || strategy > JitterDecorrelated {
+		return nil, &core.ArgError{Op: "NewBackoff", Arg: "strategy", Value: strategy, Err: ErrUnknownJitterStrategy}
+	}
+	return &Backoff{gen: g, base: base, max: maxDelay, strategy: strategy, prev: base}, nil
+}
+
+// Next returns the delay to wait before retry number attempt, counting
+// from 0. For JitterDecorrelated the delay depends on the previous call
+// instead, and attempt 0 restarts the sequence.
+//
+// If the entropy source fails, Next returns the un-jittered delay so a
+// retry loop never spins or stalls on an RNG error.
+func (b *Backoff) Next(attempt int) time.Duration {
+	if b.strategy == JitterDecorrelated {
+		return b.nextDecorrelated(attempt)
+	}
+	d := b.exponential(attempt)
+	lo := time.Duration(0)
+	if b.strategy == JitterEqual {
+		lo = d / 2
+	}
+	v, err := b.gen.Duration(lo, d)
+	if err != nil {
+		return d
+	}
+	return v
+}
+
+// Reset restarts the JitterDecorrelated sequence at Base.
+func (b *Backoff) Reset() {
+	b.mu.Lock()
+	b.prev = b.base
+	b.mu.Unlock()
+}
+
+func (b *Backoff) nextDecorrelated(attempt int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if attempt <= 0 {
+		b.prev = b.base
+	}
+	hi := b.max
+	if b.prev <= b.max/3 {
+		hi = 3 * b.prev
+	}
+	v, err := b.gen.Duration(b.base, hi)
+	if err != nil {
+		v = hi
+	}
+	b.prev = v
+	return v
+}
+
+// exponential returns base*2^attempt capped at max without overflowing.
+func (b *Backoff) exponential(attempt int) time.Duration {
+	if attempt <= 0 {
+		return b.base
+	}
+	if attempt >= 63 || b.base > b.max>>attempt {
+		return b.max
+	}
+	return b.base << attempt
+}
@@ -0,0 +1,86 @@
+package randtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestBackoffFullAndEqual(t *testing.T) {
+	base, maxDelay := 100*time.Millisecond, 5*time.Second
+	for _, strategy := range []JitterStrategy{JitterFull, JitterEqual} {
+		b, err := NewBackoff(base, maxDelay, strategy)
+		if err != nil {
+			t.Fatalf("NewBackoff error: %v", err)
+		}
+		for attempt := 0; attempt < 80; attempt++ {
+			ceil := b.exponential(attempt)
+			want := base << min(attempt, 62)
+			if attempt > 5 || want > maxDelay {
+				want = maxDelay
+			}
+			if ceil != want {
+				t.Fatalf("exponential(%d)=%v want %v", attempt, ceil, want)
+			}
+			for i := 0; i < 20; i++ {
+				d := b.Next(attempt)
+				floor := time.Duration(0)
+				if strategy == JitterEqual {
+					floor = ceil / 2
+				}
+				if d < floor || d > ceil {
+					t.Fatalf("strategy %d attempt %d: %v outside [%v, %v]", strategy, attempt, d, floor, ceil)
+				}
+			}
+		}
+	}
+}
+
+func TestBackoffDecorrelated(t *testing.T) {
+	base, maxDelay := 10*time.Millisecond, time.Second
+	b, err := NewBackoff(base, maxDelay, JitterDecorrelated)
+	if err != nil {
+		t.Fatalf("NewBackoff error: %v", err)
+	}
+	prev := base
+	for attempt := 0; attempt < 200; attempt++ {
+		if attempt%50 == 0 {
+			prev = base
+		}
+		d := b.Next(attempt % 50)
+		if d < base || d > min(maxDelay, 3*prev) {
+			t.Fatalf("attempt %d: %v outside [%v, %v]", attempt, d, base, min(maxDelay, 3*prev))
+		}
+		prev = d
+	}
+	b.Reset()
+	if d := b.Next(1); d > 3*base {
+		t.Fatalf("after Reset: %v > %v", d, 3*base)
+	}
+}
+
+func TestBackoffFallsBackOnRNGError(t *testing.T) {
+	g := New(core.New(testutil.ErrReader{Err: errors.New("boom")}))
+	b, err := g.NewBackoff(time.Second, time.Minute, JitterFull)
+	if err != nil {
+		t.Fatalf("NewBackoff error: %v", err)
+	}
+	if d := b.Next(3); d != 8*time.Second {
+		t.Fatalf("Next=%v want %v", d, 8*time.Second)
+	}
+}
+
+func TestBackoffErrors(t *testing.T) {
+	if _, err := NewBackoff(0, time.Second, JitterFull); !errors.Is(err, core.ErrNonPositiveBound) {
+		t.Fatalf("err=%v want ErrNonPositiveBound", err)
+	}
+	if _, err := NewBackoff(time.Second, time.Millisecond, JitterFull); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+	if _, err := NewBackoff(time.Second, time.Minute, JitterStrategy(7)); !errors.Is(err, ErrUnknownJitterStrategy) {
+		t.Fatalf("err=%v want ErrUnknownJitterStrategy", err)
+	}
+}
@@ -0,0 +1,9 @@
+package randtime
+
+import "testing"
+
+func BenchmarkUnixMilliBetween(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = UnixMilliBetween(1700000000000, 1800000000000)
+	}
+}
@@ -0,0 +1,78 @@
+package randtime
+
+import (
+	"errors"
+	"testing"
+	stdtime "time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestDatetimeBetween(t *testing.T) {
+	helsinki := stdtime.FixedZone("EET", 2*60*60)
+	start := stdtime.Date(2024, 2, 28, 22, 0, 0, 0, helsinki)
+	end := start.Add(3 * stdtime.Hour)
+	var sum stdtime.Duration
+	const n = 4000
+	for i := 0; i < n; i++ {
+		v, err := DatetimeBetween(start, end)
+		if err != nil {
+			t.Fatalf("DatetimeBetween error: %v", err)
+		}
+		if v.Before(start) || v.After(end) {
+			t.Fatalf("DatetimeBetween %v outside [%v, %v]", v, start, end)
+		}
+		if v.Location() != helsinki {
+			t.Fatalf("location=%v want %v", v.Location(), helsinki)
+		}
+		sum += v.Sub(start) / n
+	}
+	if mid := end.Sub(start) / 2; sum < mid-10*stdtime.Minute || sum > mid+10*stdtime.Minute {
+		t.Fatalf("mean offset %v, want ~%v", sum, mid)
+	}
+	v, err := DatetimeBetween(start, start)
+	if err != nil || !v.Equal(start) {
+		t.Fatalf("empty interval: %v err=%v", v, err)
+	}
+}
+
+func TestDatetimeBetweenWideSpan(t *testing.T) {
+	start := stdtime.Date(1, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	end := stdtime.Date(9999, 12, 31, 23, 59, 59, 999999999, stdtime.UTC)
+	centuries := map[int]bool{}
+	for i := 0; i < 500; i++ {
+		v, err := DatetimeBetween(start, end)
+		if err != nil {
+			t.Fatalf("DatetimeBetween error: %v", err)
+		}
+		if v.Before(start) || v.After(end) {
+			t.Fatalf("DatetimeBetween %v outside range", v)
+		}
+		centuries[v.Year()/1000] = true
+	}
+	if len(centuries) < 8 {
+		t.Fatalf("wide span covered only %d millennia", len(centuries))
+	}
+	// Just over the time.Duration limit with a sub-second end offset.
+	start = stdtime.Date(2000, 1, 1, 0, 0, 0, 500, stdtime.UTC)
+	end = start.Add(stdtime.Duration(1<<63 - 1)).Add(stdtime.Hour)
+	for i := 0; i < 200; i++ {
+		v, err := DatetimeBetween(start, end)
+		if err != nil || v.Before(start) || v.After(end) {
+			t.Fatalf("DatetimeBetween=%v err=%v", v, err)
+		}
+	}
+}
+
+func TestDatetimeBetweenErrors(t *testing.T) {
+	start := stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	if _, err := DatetimeBetween(start, start.Add(-1)); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.DatetimeBetween(start, start.Add(stdtime.Hour)); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
@@ -0,0 +1,64 @@
+package randtime
+
+import (
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// Birthdate returns a birth date for someone whose age today is in
+// [minAge, maxAge].
+//
+// Parameters:
+//   - minAge: The youngest age in whole years; must be >= 0.
+//   - maxAge: The oldest age in whole years.
+//
+// Returns:
+//   - time.Time: A date at midnight UTC.
+//   - error: ErrNegativeAge, ErrMinGreaterThanMax, or an error if
+//     crypto/rand fails.
+func Birthdate(minAge, maxAge int) (time.Time, error) {
+	return Default().Birthdate(minAge, maxAge)
+}
+
+// Birthdate returns a birth date, uniform over calendar days, for someone
+// whose age on the generator clock's current UTC date is in [minAge,
+// maxAge]. People born on February 29 age on March 1 in common years.
+func (g *Generator) Birthdate(minAge, maxAge int) (time.Time, error) {
+	if minAge < 0 {
+		return time.Time{}, &core.ArgError{Op: "Birthdate", Arg: "minAge", Value: minAge, Err: ErrNegativeAge}
+	}
+	if minAge > maxAge {
+		return time.Time{}, &core.RangeError{Op: "Birthdate", Min: minAge, Max: maxAge, Err: core.ErrMinGreaterThanMax}
+	}
+	y, m, d := g.nowUTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	// AddDate normalizes February 29 differently from how birthdays are
+	// counted, so widen the window by a day on each side and reject the
+	// candidates whose age falls outside the range.
+	lo := today.AddDate(-maxAge-1, 0, -1)
+	hi := today.AddDate(-minAge, 0, 1)
+	// Count days from Unix seconds: time.Duration saturates at about 292
+	// years, which would drop the youngest ages from wide windows.
+	days := int((hi.Unix() - lo.Unix()) / 86400)
+	for {
+		n, err := g.rng.IntRange(0, days)
+		if err != nil {
+			return time.Time{}, err
+		}
+		b := lo.AddDate(0, 0, n)
+		if a := ageOn(b, today); a >= minAge && a <= maxAge {
+			return b, nil
+		}
+	}
+}
+
+// ageOn returns the age in whole years on day of someone born on birth.
+func ageOn(birth, day time.Time) int {
+	age := day.Year() - birth.Year()
+	if day.Month() < birth.Month() || day.Month() == birth.Month() && day.Day() < birth.Day() {
+		age--
+	}
+	return age
+}
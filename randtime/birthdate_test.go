@@ -0,0 +1,100 @@
+package randtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestBirthdateAgeRange(t *testing.T) {
+	days := []time.Time{
+		time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC),
+		time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 31, 23, 59, 0, 0, time.UTC),
+	}
+	for _, now := range days {
+		g := NewWithClock(core.New(nil), func() time.Time { return now })
+		for _, r := range [][2]int{{0, 0}, {18, 18}, {18, 65}, {100, 120}} {
+			for i := 0; i < 300; i++ {
+				b, err := g.Birthdate(r[0], r[1])
+				if err != nil {
+					t.Fatalf("Birthdate error: %v", err)
+				}
+				if a := ageOn(b, now); a < r[0] || a > r[1] {
+					t.Fatalf("now=%v Birthdate(%d, %d)=%v has age %d", now, r[0], r[1], b, a)
+				}
+				if b.Hour() != 0 || b.Location() != time.UTC {
+					t.Fatalf("Birthdate=%v is not a UTC date", b)
+				}
+			}
+		}
+	}
+}
+
+func TestBirthdateCoversEdges(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	g := NewWithClock(core.New(nil), func() time.Time { return now })
+	seen := map[time.Time]bool{}
+	for i := 0; i < 5000; i++ {
+		b, err := g.Birthdate(1, 1)
+		if err != nil {
+			t.Fatalf("Birthdate error: %v", err)
+		}
+		seen[b] = true
+	}
+	// Age 1 on 2024-03-01 means born 2022-03-02 .. 2023-03-01.
+	for _, want := range []time.Time{
+		time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if !seen[want] {
+			t.Fatalf("edge date %v never drawn", want)
+		}
+	}
+	if len(seen) != 365 {
+		t.Fatalf("drew %d distinct dates, want 365", len(seen))
+	}
+}
+
+func TestBirthdateWideRange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	g := NewWithClock(core.New(nil), func() time.Time { return now })
+	var young, old bool
+	for i := 0; i < 2000; i++ {
+		b, err := g.Birthdate(0, 400)
+		if err != nil {
+			t.Fatalf("Birthdate error: %v", err)
+		}
+		a := ageOn(b, now)
+		if a < 0 || a > 400 {
+			t.Fatalf("Birthdate(0, 400)=%v has age %d", b, a)
+		}
+		young = young || a < 100
+		old = old || a > 300
+	}
+	if !young || !old {
+		t.Fatalf("Birthdate(0, 400) does not span the window: young=%v old=%v", young, old)
+	}
+}
+
+func TestAgeOnLeapDay(t *testing.T) {
+	leap := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	if a := ageOn(leap, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)); a != 22 {
+		t.Fatalf("age=%d want 22", a)
+	}
+	if a := ageOn(leap, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)); a != 23 {
+		t.Fatalf("age=%d want 23", a)
+	}
+}
+
+func TestBirthdateErrors(t *testing.T) {
+	if _, err := Birthdate(-1, 5); !errors.Is(err, ErrNegativeAge) {
+		t.Fatalf("err=%v want ErrNegativeAge", err)
+	}
+	if _, err := Birthdate(30, 20); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
@@ -0,0 +1,192 @@
+package randtime
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// CronGranularity sets how often a CronExpr schedule fires: every field
+// finer than the granularity is pinned, the rest are wildcards.
+type CronGranularity int
+
+// Supported cron granularities.
+const (
+	// CronAny pins or frees each field at random.
+	CronAny CronGranularity = iota
+	// CronMinutely fires every minute (or every step, with Extended).
+	CronMinutely
+	// CronHourly pins the minute.
+	CronHourly
+	// CronDaily pins the minute and hour.
+	CronDaily
+	// CronWeekly pins the minute, hour and day of week.
+	CronWeekly
+	// CronMonthly pins the minute, hour and day of month.
+	CronMonthly
+	// CronYearly pins the minute, hour, day of month and month.
+	CronYearly
+)
+
+// CronOptions configures CronExpr.
+type CronOptions struct {
+	// Granularity sets how often the schedule fires.
+	Granularity CronGranularity
+
+	// Extended allows steps ("*/15"), ranges ("1-5"), stepped ranges and
+	// lists ("0,30") in fields the granularity leaves free. Pinned fields
+	// stay single values, so the schedule never fires more often than the
+	// granularity.
+	Extended bool
+}
+
+// cronField is the inclusive value range of one cron field. Pinned days of
+// month stop at 28 so the schedule fires in every month.
+type cronField struct{ lo, hi, pinHi int }
+
+var cronFields = [5]cronField{
+	{0, 59, 59}, // minute
+	{0, 23, 23}, // hour
+	{1, 31, 28}, // day of month
+	{1, 12, 12}, // month
+	{0, 6, 6},   // day of week
+}
+
+// cronPinned lists, per granularity, which fields hold fixed values.
+var cronPinned = map[CronGranularity][5]bool{
+	CronMinutely: {},
+	CronHourly:   {true},
+	CronDaily:    {true, true},
+	CronWeekly:   {true, true, false, false, true},
+	CronMonthly:  {true, true, true},
+	CronYearly:   {true, true, true, true},
+}
+
+// CronExpr returns a random, valid five-field cron expression.
+//
+// Parameters:
+//   - opts: Granularity and syntax options.
+//
+// Returns:
+//   - string: An expression such as "30 9 * * 1" (minute, hour, day of
+//     month, month, day of week).
+//   - error: ErrUnknownGranularity, or an error if crypto/rand fails.
+func CronExpr(opts CronOptions) (string, error) {
+	return Default().CronExpr(opts)
+}
+
+// CronExpr returns a random, valid five-field cron expression.
+func (g *Generator) CronExpr(opts CronOptions) (string, error) {
+	var pinned [5]bool
+	if opts.Granularity == CronAny {
+		for i := range pinned {
+			coin, err := g.rng.IntRange(0, 1)
+			if err != nil {
+				return "", err
+			}
+			pinned[i] = coin == 1
+		}
+	} else {
+		var ok bool
+		if pinned, ok = cronPinned[opts.Granularity]; !ok {
+			return "", &core.ArgError{Op: "CronExpr", Arg: "Granularity", Value: opts.Granularity, Err: ErrUnknownGranularity}
+		}
+	}
+	fields := make([]string, len(cronFields))
+	for i, f := range cronFields {
+		var err error
+		if pinned[i] {
+			fields[i], err = g.cronPinnedField(f)
+		} else {
+			fields[i], err = g.cronFreeField(f, opts.Extended)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	// Cron fires when either day field matches if both are restricted, so
+	// keep one of them a wildcard, preferring to free the one not pinned.
+	if fields[2] != "*" && fields[4] != "*" {
+		if pinned[4] && !pinned[2] {
+			fields[2] = "*"
+		} else {
+			fields[4] = "*"
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// cronPinnedField returns a single value.
+func (g *Generator) cronPinnedField(f cronField) (string, error) {
+	v, err := g.rng.IntRange(f.lo, f.pinHi)
+	return strconv.Itoa(v), err
+}
+
+// cronFreeField returns "*", or with extended syntax a step, range,
+// stepped range or list spanning the field.
+func (g *Generator) cronFreeField(f cronField, extended bool) (string, error) {
+	if !extended {
+		return "*", nil
+	}
+	form, err := g.rng.IntRange(0, 4)
+	if err != nil {
+		return "", err
+	}
+	switch form {
+	case 1:
+		step, err := g.rng.IntRange(2, (f.hi-f.lo+1)/2)
+		return "*/" + strconv.Itoa(step), err
+	case 2:
+		return g.cronRange(f.lo, f.hi)
+	case 3:
+		r, err := g.cronRange(f.lo, f.hi)
+		if err != nil {
+			return "", err
+		}
+		step, err := g.rng.IntRange(2, 3)
+		return r + "/" + strconv.Itoa(step), err
+	case 4:
+		return g.cronList(f.lo, f.hi)
+	}
+	return "*", nil
+}
+
+// cronRange returns "a-b" with lo <= a < b <= hi.
+func (g *Generator) cronRange(lo, hi int) (string, error) {
+	a, err := g.rng.IntRange(lo, hi-1)
+	if err != nil {
+		return "", err
+	}
+	b, err := g.rng.IntRange(a+1, hi)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(a) + "-" + strconv.Itoa(b), nil
+}
+
+// cronList returns two to four distinct ascending values in [lo, hi]
+// joined by commas.
+func (g *Generator) cronList(lo, hi int) (string, error) {
+	n, err := g.rng.IntRange(2, 4)
+	if err != nil {
+		return "", err
+	}
+	vals := make([]int, 0, n)
+	for len(vals) < n {
+		v, err := g.rng.IntRange(lo, hi)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(vals, v) {
+			vals = append(vals, v)
+		}
+	}
+	slices.Sort(vals)
+	parts := make([]string, n)
+	for i, v := range vals {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ","), nil
+}
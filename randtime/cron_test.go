@@ -0,0 +1,155 @@
+package randtime
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// checkCronField validates one field against the standard cron grammar:
+// "*", "*/n", "a", "a-b", "a-b/n" or a comma list of values and ranges.
+func checkCronField(t *testing.T, expr, field string, f cronField) {
+	t.Helper()
+	num := func(s string) int {
+		v, err := strconv.Atoi(s)
+		if err != nil || v < f.lo || v > f.hi {
+			t.Fatalf("%q: bad value %q in field %q", expr, s, field)
+		}
+		return v
+	}
+	for _, item := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 {
+				t.Fatalf("%q: bad step in %q", expr, field)
+			}
+		}
+		if base == "*" {
+			continue
+		}
+		if a, b, ok := strings.Cut(base, "-"); ok {
+			if num(a) >= num(b) {
+				t.Fatalf("%q: empty range %q", expr, base)
+			}
+			continue
+		}
+		num(base)
+	}
+}
+
+func TestCronExprValid(t *testing.T) {
+	for gran := CronAny; gran <= CronYearly; gran++ {
+		for _, extended := range []bool{false, true} {
+			for i := 0; i < 300; i++ {
+				expr, err := CronExpr(CronOptions{Granularity: gran, Extended: extended})
+				if err != nil {
+					t.Fatalf("CronExpr error: %v", err)
+				}
+				fields := strings.Fields(expr)
+				if len(fields) != 5 {
+					t.Fatalf("%q has %d fields", expr, len(fields))
+				}
+				for j, field := range fields {
+					checkCronField(t, expr, field, cronFields[j])
+					if !extended && field != "*" {
+						if _, err := strconv.Atoi(field); err != nil {
+							t.Fatalf("%q uses extended syntax", expr)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCronExprGranularity(t *testing.T) {
+	for gran, pinned := range cronPinned {
+		for i := 0; i < 100; i++ {
+			expr, err := CronExpr(CronOptions{Granularity: gran})
+			if err != nil {
+				t.Fatalf("CronExpr error: %v", err)
+			}
+			for j, field := range strings.Fields(expr) {
+				if pinned[j] == (field == "*") {
+					t.Fatalf("granularity %d: %q field %d=%q", gran, expr, j, field)
+				}
+			}
+		}
+	}
+	if _, err := CronExpr(CronOptions{Granularity: 42}); !errors.Is(err, ErrUnknownGranularity) {
+		t.Fatalf("err=%v want ErrUnknownGranularity", err)
+	}
+}
+
+// cronSet expands a valid field into the set of values it matches.
+func cronSet(field string, f cronField) map[int]bool {
+	set := map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		base, stepStr, _ := strings.Cut(item, "/")
+		step := 1
+		if stepStr != "" {
+			step, _ = strconv.Atoi(stepStr)
+		}
+		lo, hi := f.lo, f.hi
+		if base != "*" {
+			a, b, ok := strings.Cut(base, "-")
+			lo, _ = strconv.Atoi(a)
+			hi = lo
+			if ok {
+				hi, _ = strconv.Atoi(b)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set
+}
+
+func TestCronExprExtendedFrequency(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for gran := CronMinutely; gran <= CronYearly; gran++ {
+		for i := 0; i < 200; i++ {
+			expr, err := CronExpr(CronOptions{Granularity: gran, Extended: true})
+			if err != nil {
+				t.Fatalf("CronExpr error: %v", err)
+			}
+			fields := strings.Fields(expr)
+			if fields[2] != "*" && fields[4] != "*" {
+				t.Fatalf("granularity %d: %q restricts both day fields", gran, expr)
+			}
+			var sets [5]map[int]bool
+			for j, field := range fields {
+				sets[j] = cronSet(field, cronFields[j])
+			}
+			if gran >= CronHourly && len(sets[0]) != 1 {
+				t.Fatalf("granularity %d: %q fires more than once an hour", gran, expr)
+			}
+			if gran >= CronDaily && len(sets[1]) != 1 {
+				t.Fatalf("granularity %d: %q fires more than once a day", gran, expr)
+			}
+			// Walk two years of days; with one day field a wildcard, a day
+			// matches when both day fields and the month match.
+			var days []time.Time
+			for d := start; d.Year() < 2027; d = d.AddDate(0, 0, 1) {
+				if sets[3][int(d.Month())] && sets[2][d.Day()] && sets[4][int(d.Weekday())] {
+					days = append(days, d)
+				}
+			}
+			if len(days) == 0 {
+				t.Fatalf("granularity %d: %q never fires", gran, expr)
+			}
+			for k := 1; k < len(days); k++ {
+				prev, cur := days[k-1], days[k]
+				switch {
+				case gran == CronWeekly && cur.Sub(prev) < 7*24*time.Hour,
+					gran == CronMonthly && cur.Year() == prev.Year() && cur.Month() == prev.Month(),
+					gran == CronYearly && cur.Year() == prev.Year():
+					t.Fatalf("granularity %d: %q fires on %v and %v", gran, expr, prev, cur)
+				}
+			}
+		}
+	}
+}
@@ -30,3 +30,31 @@ func TimeInNearPast() (time.Time, error) {
 func TimeInNearFuture() (time.Time, error) {
 	return Default().TimeInNearFuture()
 }
+
+// TimeInPast returns a time between minAgo and maxAgo in the past.
+//
+// Parameters:
+//   - minAgo: The smallest offset into the past; must be >= 0.
+//   - maxAgo: The largest offset into the past.
+//
+// Returns:
+//   - time.Time: A random UTC time in [now-maxAgo, now-minAgo].
+//   - error: ErrNegativeDuration, ErrMinGreaterThanMax, or an error if
+//     crypto/rand fails.
+func TimeInPast(minAgo, maxAgo time.Duration) (time.Time, error) {
+	return Default().TimeInPast(minAgo, maxAgo)
+}
+
+// TimeInFuture returns a time between minAhead and maxAhead in the future.
+//
+// Parameters:
+//   - minAhead: The smallest offset into the future; must be >= 0.
+//   - maxAhead: The largest offset into the future.
+//
+// Returns:
+//   - time.Time: A random UTC time in [now+minAhead, now+maxAhead].
+//   - error: ErrNegativeDuration, ErrMinGreaterThanMax, or an error if
+//     crypto/rand fails.
+func TimeInFuture(minAhead, maxAhead time.Duration) (time.Time, error) {
+	return Default().TimeInFuture(minAhead, maxAhead)
+}
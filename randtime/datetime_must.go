@@ -52,3 +52,133 @@ func MustJitter(base time.Duration, pct float64) time.Duration {
 	}
 	return d
 }
+
+// MustDatetimeBetween returns a random time in [start, end]. It panics if an
+// error occurs.
+func MustDatetimeBetween(start, end time.Time) time.Time {
+	t, err := DatetimeBetween(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustDuration returns a random duration in [minD, maxD]. It panics if an
+// error occurs.
+func MustDuration(minD, maxD time.Duration) time.Duration {
+	d, err := Duration(minD, maxD)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// MustDurationAround returns a random duration within frac of base. It
+// panics if an error occurs.
+func MustDurationAround(base time.Duration, frac float64) time.Duration {
+	d, err := DurationAround(base, frac)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// MustNewBackoff returns a Backoff using the default generator. It panics if
+// an error occurs.
+func MustNewBackoff(base, maxDelay time.Duration, strategy JitterStrategy) *Backoff {
+	b, err := NewBackoff(base, maxDelay, strategy)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// MustDatetimeWithin returns a random time satisfying opts. It panics if an
+// error occurs.
+func MustDatetimeWithin(opts WithinOptions) time.Time {
+	t, err := DatetimeWithin(opts)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustTimeInPast returns a time between minAgo and maxAgo in the past. It
+// panics if an error occurs.
+func MustTimeInPast(minAgo, maxAgo time.Duration) time.Time {
+	t, err := TimeInPast(minAgo, maxAgo)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustTimeInFuture returns a time between minAhead and maxAhead in the
+// future. It panics if an error occurs.
+func MustTimeInFuture(minAhead, maxAhead time.Duration) time.Time {
+	t, err := TimeInFuture(minAhead, maxAhead)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustBirthdate returns a birth date for an age in [minAge, maxAge]. It
+// panics if an error occurs.
+func MustBirthdate(minAge, maxAge int) time.Time {
+	t, err := Birthdate(minAge, maxAge)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustEventTimes returns n sorted random timestamps in [start, end]. It
+// panics if an error occurs.
+func MustEventTimes(start, end time.Time, n int) []time.Time {
+	ts, err := EventTimes(start, end, n)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// MustPoissonProcess returns Poisson arrival offsets within window. It
+// panics if an error occurs.
+func MustPoissonProcess(rate float64, window time.Duration) []time.Duration {
+	ts, err := PoissonProcess(rate, window)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// MustCronExpr returns a random five-field cron expression. It panics if an
+// error occurs.
+func MustCronExpr(opts CronOptions) string {
+	expr, err := CronExpr(opts)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// MustUnixBetween returns a random Unix timestamp in seconds in [startUnix,
+// endUnix]. It panics if an error occurs.
+func MustUnixBetween(startUnix, endUnix int64) int64 {
+	v, err := UnixBetween(startUnix, endUnix)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustUnixMilliBetween returns a random Unix timestamp in milliseconds in
+// [startMilli, endMilli]. It panics if an error occurs.
+func MustUnixMilliBetween(startMilli, endMilli int64) int64 {
+	v, err := UnixMilliBetween(startMilli, endMilli)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
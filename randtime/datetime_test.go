@@ -1,6 +1,7 @@
 package randtime
 
 import (
+	"errors"
 	"testing"
 	stdtime "time"
 
@@ -80,6 +81,33 @@ func TestTimeInNearPastFutureWithClock(t *testing.T) {
 	}
 }
 
+func TestTimeInPastFutureWithClock(t *testing.T) {
+	fixed := stdtime.Date(2024, 1, 2, 3, 4, 5, 0, stdtime.UTC)
+	gen := NewWithClock(core.New(nil), func() stdtime.Time { return fixed })
+	for i := 0; i < 200; i++ {
+		past, err := gen.TimeInPast(stdtime.Hour, 48*stdtime.Hour)
+		if err != nil {
+			t.Fatalf("TimeInPast error: %v", err)
+		}
+		if d := fixed.Sub(past); d < stdtime.Hour || d > 48*stdtime.Hour {
+			t.Fatalf("TimeInPast offset %v outside [1h, 48h]", d)
+		}
+		future, err := gen.TimeInFuture(0, stdtime.Second)
+		if err != nil {
+			t.Fatalf("TimeInFuture error: %v", err)
+		}
+		if d := future.Sub(fixed); d < 0 || d > stdtime.Second {
+			t.Fatalf("TimeInFuture offset %v outside [0, 1s]", d)
+		}
+	}
+	if _, err := gen.TimeInPast(-stdtime.Second, stdtime.Second); !errors.Is(err, core.ErrNegativeDuration) {
+		t.Fatalf("err=%v want ErrNegativeDuration", err)
+	}
+	if _, err := TimeInFuture(stdtime.Hour, stdtime.Minute); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
 func TestDaysInMonth(t *testing.T) {
 	tests := []struct {
 		year  int
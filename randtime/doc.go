@@ -1,3 +1,4 @@
-// Package randtime provides random datetime generation and jitter helpers.
+// Package randtime provides random datetime generation, jitter and backoff
+// helpers.
 // Generators are concurrency-safe iff the injected RNG is safe.
 package randtime
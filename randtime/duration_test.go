@@ -0,0 +1,67 @@
+package randtime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestDuration(t *testing.T) {
+	cases := []struct{ lo, hi time.Duration }{
+		{100 * time.Millisecond, 250 * time.Millisecond},
+		{-time.Second, time.Second},
+		{time.Minute, time.Minute},
+		{math.MinInt64, math.MaxInt64},
+		{0, math.MaxInt64},
+	}
+	for _, tc := range cases {
+		for i := 0; i < 200; i++ {
+			d, err := Duration(tc.lo, tc.hi)
+			if err != nil {
+				t.Fatalf("Duration(%v, %v) error: %v", tc.lo, tc.hi, err)
+			}
+			if d < tc.lo || d > tc.hi {
+				t.Fatalf("Duration(%v, %v)=%v", tc.lo, tc.hi, d)
+			}
+		}
+	}
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 200; i++ {
+		d, _ := Duration(0, 3)
+		seen[d] = true
+	}
+	if len(seen) != 4 {
+		t.Fatalf("Duration(0, 3) hit %d of 4 values", len(seen))
+	}
+	if _, err := Duration(time.Second, 0); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func TestDurationAround(t *testing.T) {
+	base := 30 * time.Second
+	for i := 0; i < 500; i++ {
+		d, err := DurationAround(base, 0.1)
+		if err != nil {
+			t.Fatalf("DurationAround error: %v", err)
+		}
+		if d < 27*time.Second || d > 33*time.Second {
+			t.Fatalf("DurationAround=%v outside ±10%%", d)
+		}
+	}
+	if d, err := DurationAround(base, 0); err != nil || d != base {
+		t.Fatalf("DurationAround(frac=0)=%v err=%v", d, err)
+	}
+	if d, err := DurationAround(math.MaxInt64, 1); err != nil || d < 0 {
+		t.Fatalf("DurationAround(max)=%v err=%v", d, err)
+	}
+	if _, err := DurationAround(-time.Second, 0.1); !errors.Is(err, core.ErrNegativeDuration) {
+		t.Fatalf("err=%v want ErrNegativeDuration", err)
+	}
+	if _, err := DurationAround(time.Second, 1.1); !errors.Is(err, core.ErrInvalidJitter) {
+		t.Fatalf("err=%v want ErrInvalidJitter", err)
+	}
+}
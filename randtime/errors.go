@@ -0,0 +1,10 @@
+package randtime
+
+import "errors"
+
+// Package-level errors for time generation.
+var (
+	ErrUnknownJitterStrategy = errors.New("randutil: unknown jitter strategy")
+	ErrNegativeAge           = errors.New("randutil: age must be >= 0")
+	ErrUnknownGranularity    = errors.New("randutil: unknown cron granularity")
+)
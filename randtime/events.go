@@ -0,0 +1,86 @@
+package randtime
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// EventTimes returns n timestamps drawn independently and uniformly from
+// [start, end], sorted in ascending order.
+//
+// Parameters:
+//   - start: The earliest time.
+//   - end: The latest time.
+//   - n: Number of timestamps.
+//
+// Returns:
+//   - []time.Time: n sorted timestamps in start's location.
+//   - error: ErrNegativeLength, ErrMinGreaterThanMax, or an error if
+//     crypto/rand fails.
+func EventTimes(start, end time.Time, n int) ([]time.Time, error) {
+	return Default().EventTimes(start, end, n)
+}
+
+// PoissonProcess returns the arrival offsets of a homogeneous Poisson
+// process observed for window.
+//
+// Parameters:
+//   - rate: Mean number of events per second; must be > 0.
+//   - window: Observation length; must be >= 0.
+//
+// Returns:
+//   - []time.Duration: Increasing offsets in [0, window] whose gaps are
+//     exponentially distributed with mean 1/rate seconds.
+//   - error: ErrNonPositiveRate, ErrNegativeDuration, or an error if
+//     crypto/rand fails.
+func PoissonProcess(rate float64, window time.Duration) ([]time.Duration, error) {
+	return Default().PoissonProcess(rate, window)
+}
+
+// EventTimes returns n sorted timestamps drawn uniformly from [start, end].
+func (g *Generator) EventTimes(start, end time.Time, n int) ([]time.Time, error) {
+	if n < 0 {
+		return nil, &core.ArgError{Op: "EventTimes", Arg: "n", Value: n, Err: core.ErrNegativeLength}
+	}
+	if end.Before(start) {
+		return nil, &core.RangeError{Op: "EventTimes", Min: start, Max: end, Err: core.ErrMinGreaterThanMax}
+	}
+	out := make([]time.Time, n)
+	for i := range out {
+		t, err := g.DatetimeBetween(start, end)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = t
+	}
+	slices.SortFunc(out, time.Time.Compare)
+	return out, nil
+}
+
+// PoissonProcess returns Poisson arrival offsets within window at rate
+// events per second.
+func (g *Generator) PoissonProcess(rate float64, window time.Duration) ([]time.Duration, error) {
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return nil, &core.ArgError{Op: "PoissonProcess", Arg: "rate", Value: rate, Err: core.ErrNonPositiveRate}
+	}
+	if window < 0 {
+		return nil, &core.ArgError{Op: "PoissonProcess", Arg: "window", Value: window, Err: core.ErrNegativeDuration}
+	}
+	limit := window.Seconds()
+	out := make([]time.Duration, 0, int(math.Min(rate*limit, 1<<20))+1)
+	t := 0.0
+	for {
+		u, err := g.rng.Float64()
+		if err != nil {
+			return nil, err
+		}
+		t += -math.Log1p(-u) / rate
+		if t > limit {
+			return out, nil
+		}
+		out = append(out, time.Duration(t*float64(time.Second)))
+	}
+}
@@ -0,0 +1,70 @@
+package randtime
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestEventTimes(t *testing.T) {
+	start := time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC)
+	end := start.Add(8 * time.Hour)
+	ts, err := EventTimes(start, end, 500)
+	if err != nil || len(ts) != 500 {
+		t.Fatalf("EventTimes len=%d err=%v", len(ts), err)
+	}
+	if !slices.IsSortedFunc(ts, time.Time.Compare) {
+		t.Fatal("EventTimes not sorted")
+	}
+	if ts[0].Before(start) || ts[len(ts)-1].After(end) {
+		t.Fatalf("EventTimes outside range: %v .. %v", ts[0], ts[len(ts)-1])
+	}
+	if ts, err := EventTimes(start, end, 0); err != nil || len(ts) != 0 {
+		t.Fatalf("EventTimes(0)=%v err=%v", ts, err)
+	}
+	if _, err := EventTimes(start, end, -1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("err=%v want ErrNegativeLength", err)
+	}
+	if _, err := EventTimes(end, start, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+}
+
+func TestPoissonProcess(t *testing.T) {
+	const rate, trials = 5.0, 200
+	window := 20 * time.Second
+	total := 0
+	for i := 0; i < trials; i++ {
+		ts, err := PoissonProcess(rate, window)
+		if err != nil {
+			t.Fatalf("PoissonProcess error: %v", err)
+		}
+		if !slices.IsSorted(ts) {
+			t.Fatal("PoissonProcess offsets not increasing")
+		}
+		if len(ts) > 0 && (ts[0] < 0 || ts[len(ts)-1] > window) {
+			t.Fatalf("offsets outside window: %v .. %v", ts[0], ts[len(ts)-1])
+		}
+		total += len(ts)
+	}
+	// Mean count is rate*window = 100 per trial; the sample mean over 200
+	// trials has standard deviation sqrt(100/200) ~= 0.7.
+	if mean := float64(total) / trials; math.Abs(mean-100) > 4 {
+		t.Fatalf("mean count %.2f, want ~100", mean)
+	}
+	if ts, err := PoissonProcess(rate, 0); err != nil || len(ts) != 0 {
+		t.Fatalf("empty window: %v err=%v", ts, err)
+	}
+	for _, bad := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if _, err := PoissonProcess(bad, window); !errors.Is(err, core.ErrNonPositiveRate) {
+			t.Fatalf("rate %v: err=%v want ErrNonPositiveRate", bad, err)
+		}
+	}
+	if _, err := PoissonProcess(rate, -time.Second); !errors.Is(err, core.ErrNegativeDuration) {
+		t.Fatalf("err=%v want ErrNegativeDuration", err)
+	}
+}
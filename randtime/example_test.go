@@ -10,3 +10,19 @@ func ExampleDatetime() {
 	fmt.Println(t.Location() == time.UTC)
 	// Output: true
 }
+
+func ExampleBackoff() {
+	b, err := NewBackoff(100*time.Millisecond, 10*time.Second, JitterFull)
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	for attempt := 0; attempt < 3; attempt++ {
+		d := b.Next(attempt)
+		fmt.Println(d <= 100*time.Millisecond<<attempt)
+	}
+	// Output:
+	// true
+	// true
+	// true
+}
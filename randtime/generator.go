@@ -88,11 +88,7 @@ func (g *Generator) Datetime() (time.Time, error) {
 //   - time.Time: A random time 5-10 minutes in the past.
 //   - error: An error if entropy fails.
 func (g *Generator) TimeInNearPast() (time.Time, error) {
-	offset, err := g.rng.IntRange(5, 10)
-	if err != nil {
-		return time.Time{}, err
-	}
-	return g.nowUTC().Add(-time.Minute * time.Duration(offset)), nil
+	return g.TimeInPast(5*time.Minute, 10*time.Minute)
 }
 
 // TimeInNearFuture returns a time a few minutes in the future.
@@ -101,11 +97,48 @@ func (g *Generator) TimeInNearPast() (time.Time, error) {
 //   - time.Time: A random time 5-10 minutes in the future.
 //   - error: An error if entropy fails.
 func (g *Generator) TimeInNearFuture() (time.Time, error) {
-	offset, err := g.rng.IntRange(5, 10)
+	return g.TimeInFuture(5*time.Minute, 10*time.Minute)
+}
+
+// TimeInPast returns a time between minAgo and maxAgo before the
+// generator's clock.
+//
+// Returns:
+//   - time.Time: A random UTC time in [now-maxAgo, now-minAgo].
+//   - error: ErrNegativeDuration, ErrMinGreaterThanMax, or an error if
+//     entropy fails.
+func (g *Generator) TimeInPast(minAgo, maxAgo time.Duration) (time.Time, error) {
+	d, err := g.offset("TimeInPast", minAgo, maxAgo)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return g.nowUTC().Add(-d), nil
+}
+
+// TimeInFuture returns a time between minAhead and maxAhead after the
+// generator's clock.
+//
+// Returns:
+//   - time.Time: A random UTC time in [now+minAhead, now+maxAhead].
+//   - error: ErrNegativeDuration, ErrMinGreaterThanMax, or an error if
+//     entropy fails.
+func (g *Generator) TimeInFuture(minAhead, maxAhead time.Duration) (time.Time, error) {
+	d, err := g.offset("TimeInFuture", minAhead, maxAhead)
 	if err != nil {
 		return time.Time{}, err
 	}
-	return g.nowUTC().Add(time.Minute * time.Duration(offset)), nil
+	return g.nowUTC().Add(d), nil
+}
+
+// offset draws a non-negative duration in [minD, maxD] for op.
+func (g *Generator) offset(op string, minD, maxD time.Duration) (time.Duration, error) {
+	if minD < 0 {
+		return 0, &core.ArgError{Op: op, Arg: "min", Value: minD, Err: core.ErrNegativeDuration}
+	}
+	if minD > maxD {
+		return 0, &core.RangeError{Op: op, Min: minD, Max: maxD, Err: core.ErrMinGreaterThanMax}
+	}
+	return g.Duration(minD, maxD)
 }
 
 func (g *Generator) nowUTC() time.Time {
@@ -2,5 +2,6 @@ package randtime
 
 type rng interface {
 	IntRange(minInclusive, maxInclusive int) (int, error)
+	Uint64n(n uint64) (uint64, error)
 	Float64() (float64, error)
 }
@@ -0,0 +1,50 @@
+package randtime
+
+import "github.com/aatuh/randutil/v2/core"
+
+// UnixBetween returns a Unix timestamp in seconds uniformly distributed in
+// [startUnix, endUnix], without building a time.Time.
+//
+// Parameters:
+//   - startUnix: The earliest timestamp.
+//   - endUnix: The latest timestamp.
+//
+// Returns:
+//   - int64: A random timestamp in [startUnix, endUnix].
+//   - error: ErrMinGreaterThanMax if endUnix < startUnix, or an error if
+//     crypto/rand fails.
+func UnixBetween(startUnix, endUnix int64) (int64, error) {
+	return Default().UnixBetween(startUnix, endUnix)
+}
+
+// UnixMilliBetween returns a Unix timestamp in milliseconds uniformly
+// distributed in [startMilli, endMilli].
+//
+// Parameters:
+//   - startMilli: The earliest timestamp.
+//   - endMilli: The latest timestamp.
+//
+// Returns:
+//   - int64: A random timestamp in [startMilli, endMilli].
+//   - error: ErrMinGreaterThanMax if endMilli < startMilli, or an error if
+//     crypto/rand fails.
+func UnixMilliBetween(startMilli, endMilli int64) (int64, error) {
+	return Default().UnixMilliBetween(startMilli, endMilli)
+}
+
+// UnixBetween returns a Unix timestamp in seconds in [startUnix, endUnix].
+func (g *Generator) UnixBetween(startUnix, endUnix int64) (int64, error) {
+	if startUnix > endUnix {
+		return 0, &core.RangeError{Op: "UnixBetween", Min: startUnix, Max: endUnix, Err: core.ErrMinGreaterThanMax}
+	}
+	return g.int64Between(startUnix, endUnix)
+}
+
+// UnixMilliBetween returns a Unix timestamp in milliseconds in
+// [startMilli, endMilli].
+func (g *Generator) UnixMilliBetween(startMilli, endMilli int64) (int64, error) {
+	if startMilli > endMilli {
+		return 0, &core.RangeError{Op: "UnixMilliBetween", Min: startMilli, Max: endMilli, Err: core.ErrMinGreaterThanMax}
+	}
+	return g.int64Between(startMilli, endMilli)
+}
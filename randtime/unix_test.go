@@ -0,0 +1,53 @@
+package randtime
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestUnixBetween(t *testing.T) {
+	cases := [][2]int64{
+		{1700000000, 1800000000},
+		{-100, 100},
+		{42, 42},
+		{math.MinInt64, math.MaxInt64},
+	}
+	for _, c := range cases {
+		for i := 0; i < 200; i++ {
+			v, err := UnixBetween(c[0], c[1])
+			if err != nil || v < c[0] || v > c[1] {
+				t.Fatalf("UnixBetween(%d, %d)=%d err=%v", c[0], c[1], v, err)
+			}
+			m, err := UnixMilliBetween(c[0], c[1])
+			if err != nil || m < c[0] || m > c[1] {
+				t.Fatalf("UnixMilliBetween(%d, %d)=%d err=%v", c[0], c[1], m, err)
+			}
+		}
+	}
+	seen := map[int64]bool{}
+	for i := 0; i < 200; i++ {
+		v, _ := UnixMilliBetween(10, 12)
+		seen[v] = true
+	}
+	if len(seen) != 3 {
+		t.Fatalf("UnixMilliBetween(10, 12) hit %d of 3 values", len(seen))
+	}
+}
+
+func TestUnixBetweenErrors(t *testing.T) {
+	if _, err := UnixBetween(2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+	if _, err := UnixMilliBetween(2, 1); !errors.Is(err, core.ErrMinGreaterThanMax) {
+		t.Fatalf("err=%v want ErrMinGreaterThanMax", err)
+	}
+	errBoom := errors.New("boom")
+	g := New(core.New(testutil.ErrReader{Err: errBoom}))
+	if _, err := g.UnixBetween(0, 10); !errors.Is(err, errBoom) {
+		t.Fatalf("err=%v want %v", err, errBoom)
+	}
+}
@@ -0,0 +1,150 @@
+package randtime
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// WithinOptions constrains DatetimeWithin. Empty fields impose no
+// constraint.
+type WithinOptions struct {
+	// Start and End bound the result to [Start, End). If both are zero, the
+	// year before the generator's clock is used. The span must fit in a
+	// time.Duration (about 292 years).
+	Start time.Time
+	End   time.Time
+
+	// Weekdays lists the allowed days of the week.
+	Weekdays []time.Weekday
+
+	// Months lists the allowed months.
+	Months []time.Month
+
+	// HourFrom and HourTo give the allowed time of day [HourFrom:00,
+	// HourTo:00), each in [0, 24]; 9 and 17 mean office hours. If
+	// HourFrom > HourTo the window wraps past midnight. If they are equal
+	// every hour is allowed.
+	HourFrom int
+	HourTo   int
+
+	// Location is the time zone in which weekdays, months and hours are
+	// evaluated and in which the result is returned. If nil, Start's
+	// location is used.
+	Location *time.Location
+}
+
+// Weekdays is the Monday-Friday working week, for WithinOptions.Weekdays.
+var Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// DatetimeWithin returns a time uniformly distributed over the instants
+// that satisfy opts.
+//
+// Parameters:
+//   - opts: The range and calendar constraints.
+//
+// Returns:
+//   - time.Time: A random time satisfying every constraint.
+//   - error: ErrMinGreaterThanMax if End is before Start,
+//     ErrResultOutOfRange if the span is too wide, ErrInvalidInterval for
+//     hours outside [0, 24], ErrUnsatisfiable if no instant qualifies, or
+//     an error if entropy fails.
+func DatetimeWithin(opts WithinOptions) (time.Time, error) {
+	return Default().DatetimeWithin(opts)
+}
+
+// DatetimeWithin returns a time uniformly distributed over the instants
+// that satisfy opts, in opts.Location.
+func (g *Generator) DatetimeWithin(opts WithinOptions) (time.Time, error) {
+	const op = "DatetimeWithin"
+	if opts.Start.IsZero() && opts.End.IsZero() {
+		opts.End = g.nowUTC()
+		opts.Start = opts.End.AddDate(-1, 0, 0)
+	}
+	if opts.End.Before(opts.Start) {
+		return time.Time{}, &core.RangeError{Op: op, Min: opts.Start, Max: opts.End, Err: core.ErrMinGreaterThanMax}
+	}
+	if opts.End.Sub(opts.Start) == math.MaxInt64 {
+		return time.Time{}, &core.RangeError{Op: op, Min: opts.Start, Max: opts.End, Err: core.ErrResultOutOfRange}
+	}
+	for _, h := range []int{opts.HourFrom, opts.HourTo} {
+		if h < 0 || h > 24 {
+			return time.Time{}, &core.ArgError{Op: op, Arg: "hour", Value: h, Err: core.ErrInvalidInterval}
+		}
+	}
+	if opts.Location == nil {
+		opts.Location = opts.Start.Location()
+	}
+
+	var total time.Duration
+	opts.windows(func(from, to time.Time) bool {
+		total += to.Sub(from)
+		return true
+	})
+	if total <= 0 {
+		return time.Time{}, &core.ArgError{Op: op, Arg: "opts", Value: opts, Err: core.ErrUnsatisfiable}
+	}
+	off, err := g.Duration(0, total-1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var out time.Time
+	opts.windows(func(from, to time.Time) bool {
+		if w := to.Sub(from); off >= w {
+			off -= w
+			return true
+		}
+		out = from.Add(off)
+		return false
+	})
+	return out, nil
+}
+
+// windows calls yield for each maximal allowed interval [from, to) in
+// chronological order until yield returns false.
+func (o WithinOptions) windows(yield func(from, to time.Time) bool) {
+	start, end := o.Start.In(o.Location), o.End.In(o.Location)
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, o.Location); day.Before(end); {
+		y, m, d = day.Date()
+		next := time.Date(y, m, d+1, 0, 0, 0, 0, o.Location)
+		if o.allowDay(day) {
+			at := func(h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, o.Location) }
+			spans := [][2]time.Time{{day, next}}
+			switch {
+			case o.HourFrom < o.HourTo:
+				spans = [][2]time.Time{{at(o.HourFrom), at(o.HourTo)}}
+			case o.HourFrom > o.HourTo:
+				spans = [][2]time.Time{{day, at(o.HourTo)}, {at(o.HourFrom), next}}
+			}
+			for _, s := range spans {
+				from, to := latest(s[0], start), earliest(s[1], end)
+				if from.Before(to) && !yield(from, to) {
+					return
+				}
+			}
+		}
+		day = next
+	}
+}
+
+func (o WithinOptions) allowDay(day time.Time) bool {
+	return (len(o.Weekdays) == 0 || slices.Contains(o.Weekdays, day.Weekday())) &&
+		(len(o.Months) == 0 || slices.Contains(o.Months, day.Month()))
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
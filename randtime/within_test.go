@@ -0,0 +1,107 @@
+package randtime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+func TestDatetimeWithinBusinessHours(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	opts := WithinOptions{
+		Start:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Weekdays: Weekdays,
+		Months:   []time.Month{time.March, time.October},
+		HourFrom: 9,
+		HourTo:   17,
+		Location: berlin,
+	}
+	hours := map[int]bool{}
+	for i := 0; i < 2000; i++ {
+		v, err := DatetimeWithin(opts)
+		if err != nil {
+			t.Fatalf("DatetimeWithin error: %v", err)
+		}
+		if v.Location() != berlin {
+			t.Fatalf("location=%v want %v", v.Location(), berlin)
+		}
+		if v.Before(opts.Start) || !v.Before(opts.End) {
+			t.Fatalf("%v outside range", v)
+		}
+		if !slices.Contains(Weekdays, v.Weekday()) {
+			t.Fatalf("%v falls on %v", v, v.Weekday())
+		}
+		if v.Month() != time.March && v.Month() != time.October {
+			t.Fatalf("%v falls in %v", v, v.Month())
+		}
+		if v.Hour() < 9 || v.Hour() >= 17 {
+			t.Fatalf("%v outside 09:00-17:00", v)
+		}
+		hours[v.Hour()] = true
+	}
+	if len(hours) != 8 {
+		t.Fatalf("covered %d of 8 office hours", len(hours))
+	}
+}
+
+func TestDatetimeWithinOvernightWindow(t *testing.T) {
+	opts := WithinOptions{
+		Start:    time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC),
+		End:      time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC),
+		HourFrom: 22,
+		HourTo:   6,
+	}
+	for i := 0; i < 1000; i++ {
+		v, err := DatetimeWithin(opts)
+		if err != nil {
+			t.Fatalf("DatetimeWithin error: %v", err)
+		}
+		if h := v.Hour(); h >= 6 && h < 22 {
+			t.Fatalf("%v outside 22:00-06:00", v)
+		}
+		if v.Before(opts.Start) || !v.Before(opts.End) {
+			t.Fatalf("%v outside range", v)
+		}
+	}
+}
+
+func TestDatetimeWithinDefaultsToLastYear(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	g := NewWithClock(core.New(nil), func() time.Time { return now })
+	for i := 0; i < 200; i++ {
+		v, err := g.DatetimeWithin(WithinOptions{Weekdays: []time.Weekday{time.Sunday}})
+		if err != nil {
+			t.Fatalf("DatetimeWithin error: %v", err)
+		}
+		if v.Weekday() != time.Sunday || !v.Before(now) || v.Before(now.AddDate(-1, 0, 0)) {
+			t.Fatalf("DatetimeWithin=%v", v)
+		}
+	}
+}
+
+func TestDatetimeWithinErrors(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		opts WithinOptions
+		want error
+	}{
+		{WithinOptions{Start: start, End: start.Add(-time.Hour)}, core.ErrMinGreaterThanMax},
+		{WithinOptions{Start: start, End: start.AddDate(300, 0, 0)}, core.ErrResultOutOfRange},
+		{WithinOptions{Start: start, End: start.AddDate(0, 1, 0), HourTo: 25}, core.ErrInvalidInterval},
+		{WithinOptions{Start: start, End: start}, core.ErrUnsatisfiable},
+		// January 2024 has no Februaries.
+		{WithinOptions{Start: start, End: start.AddDate(0, 1, 0), Months: []time.Month{time.February}}, core.ErrUnsatisfiable},
+	}
+	for _, tc := range cases {
+		if _, err := DatetimeWithin(tc.opts); !errors.Is(err, tc.want) {
+			t.Fatalf("DatetimeWithin(%+v) err=%v want %v", tc.opts, err, tc.want)
+		}
+	}
+}
@@ -2,11 +2,16 @@ package randutil
 
 import (
 	"github.com/aatuh/randutil/v2/collection"
+	"github.com/aatuh/randutil/v2/color"
 	"github.com/aatuh/randutil/v2/core"
 	"github.com/aatuh/randutil/v2/dist"
 	"github.com/aatuh/randutil/v2/email"
+	"github.com/aatuh/randutil/v2/fake"
+	"github.com/aatuh/randutil/v2/geo"
 	"github.com/aatuh/randutil/v2/nanoid"
 	"github.com/aatuh/randutil/v2/numeric"
+	"github.com/aatuh/randutil/v2/randdata"
+	"github.com/aatuh/randutil/v2/randnet"
 	"github.com/aatuh/randutil/v2/randstring"
 	"github.com/aatuh/randutil/v2/randtime"
 	"github.com/aatuh/randutil/v2/ulid"
@@ -43,6 +48,21 @@ type Rand struct {
 
 	// ULID provides ULID generation.
 	ULID *ulid.Generator
+
+	// Fake provides realistic test data such as names.
+	Fake *fake.Generator
+
+	// Net provides random IP, MAC and port generation.
+	Net *randnet.Generator
+
+	// Geo provides random geographic coordinates.
+	Geo *geo.Generator
+
+	// Color provides random colors and distinct palettes.
+	Color *color.Generator
+
+	// Data provides random byte streams and temporary files.
+	Data *randdata.Generator
 }
 
 // New returns a Rand with all generators bound to src. Pass nil to use
@@ -65,6 +85,11 @@ func New(src core.Source) Rand {
 		Email:   email.New(coreGen),
 		NanoID:  nanoid.New(coreGen),
 		ULID:    ulid.New(coreGen),
+		Fake:    fake.New(coreGen),
+		Net:     randnet.New(coreGen),
+		Geo:     geo.New(coreGen),
+		Color:   color.New(coreGen),
+		Data:    randdata.New(coreGen),
 	}
 }
 
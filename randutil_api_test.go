@@ -223,7 +223,12 @@ func assertRandReady(t *testing.T, r Rand) {
 		r.Time == nil ||
 		r.Email == nil ||
 		r.NanoID == nil ||
-		r.ULID == nil {
+		r.ULID == nil ||
+		r.Fake == nil ||
+		r.Net == nil ||
+		r.Geo == nil ||
+		r.Color == nil ||
+		r.Data == nil {
 		t.Fatalf("Rand has nil generator: %#v", r)
 	}
 }
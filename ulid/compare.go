@@ -0,0 +1,19 @@
+package ulid
+
+import (
+	"slices"
+	"strings"
+)
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to
+// or after b. ULIDs compare case-insensitively, so a lower-case ULID sorts
+// by its value rather than after every upper-case one; for ULIDs this is
+// creation order at millisecond precision.
+func Compare(a, b ULID) int {
+	return strings.Compare(strings.ToUpper(string(a)), strings.ToUpper(string(b)))
+}
+
+// Sort sorts ids in place by Compare.
+func Sort(ids []ULID) {
+	slices.SortFunc(ids, Compare)
+}
@@ -0,0 +1,23 @@
+package ulid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompareIgnoresCase(t *testing.T) {
+	earlier := ULID("01arz3ndektsv4rrffq69g5fav")
+	later := ULID("01BX5ZZKBKACTAV9WEVGEMMVRZ")
+	// As plain strings, "01B..." sorts before "01a...".
+	if string(later) > string(earlier) || Compare(earlier, later) != -1 {
+		t.Fatalf("Compare(%s, %s) = %d want -1", earlier, later, Compare(earlier, later))
+	}
+	if Compare(earlier, "01ARZ3NDEKTSV4RRFFQ69G5FAV") != 0 {
+		t.Fatalf("lower-case ULID should equal its upper-case form")
+	}
+	ids := []ULID{"01BX5ZZKBKACTAV9WEVGEMMVS0", later, earlier}
+	Sort(ids)
+	if !slices.Equal(ids, []ULID{earlier, later, "01BX5ZZKBKACTAV9WEVGEMMVS0"}) {
+		t.Fatalf("Sort = %v", ids)
+	}
+}
@@ -0,0 +1,44 @@
+package uuid
+
+import "strings"
+
+// encodeBase renders src as a big-endian number of exactly n digits in the
+// given alphabet, padding with the zero digit.
+func encodeBase(src []byte, n int, alphabet string) string {
+	num := append([]byte(nil), src...)
+	base := uint(len(alphabet))
+	out := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		var rem uint
+		for j := range num {
+			acc := rem<<8 | uint(num[j])
+			num[j] = byte(acc / base)
+			rem = acc % base
+		}
+		out[i] = alphabet[rem]
+	}
+	return string(out)
+}
+
+// decodeBase parses s as a big-endian number in the given alphabet into dst
+// and reports whether s was valid and fit in len(dst) bytes.
+func decodeBase(s string, dst []byte, alphabet string) bool {
+	clear(dst)
+	base := uint(len(alphabet))
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(alphabet, s[i])
+		if d < 0 {
+			return false
+		}
+		carry := uint(d)
+		for j := len(dst) - 1; j >= 0; j-- {
+			acc := uint(dst[j])*base + carry
+			dst[j] = byte(acc)
+			carry = acc >> 8
+		}
+		if carry != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package uuid
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+)
+
+// Bytes16 is the binary form of a UUID. As a comparable array it is a
+// compact map key: 16 bytes instead of a 36-byte string.
+type Bytes16 [16]byte
+
+// Key returns the binary form of u for use as a map key.
+//
+// Returns:
+//   - Bytes16: The 16 UUID bytes.
+//   - error: An error if u is not a canonical lower-case UUID.
+func (u UUID) Key() (Bytes16, error) {
+	b, err := u.Bytes()
+	return Bytes16(b), err
+}
+
+// UUID returns the canonical textual form of k.
+func (k Bytes16) UUID() UUID { return fromBytes(k) }
+
+// String returns the canonical textual form of k.
+func (k Bytes16) String() string { return string(fromBytes(k)) }
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to
+// or after b by their 128-bit values. Hex digits compare case-insensitively,
+// so an upper-case UUID equals its lower-case form. Values that are not
+// UUIDs sort before all UUIDs and are compared as strings.
+//
+// Parameters:
+//   - a: The first UUID.
+//   - b: The second UUID.
+//
+// Returns:
+//   - int: The comparison result.
+func Compare(a, b UUID) int {
+	ab, aok := compareKey(a)
+	bb, bok := compareKey(b)
+	switch {
+	case aok && bok:
+		return bytes.Compare(ab[:], bb[:])
+	case aok:
+		return 1
+	case bok:
+		return -1
+	default:
+		return strings.Compare(string(a), string(b))
+	}
+}
+
+// Less reports whether a sorts before b according to Compare.
+func Less(a, b UUID) bool {
+	return Compare(a, b) < 0
+}
+
+// Sort sorts ids in place by Compare. For v6 and v7 UUIDs this is creation
+// order; use SortByTime for v1.
+func Sort(ids []UUID) {
+	slices.SortFunc(ids, Compare)
+}
+
+// SortByTime sorts ids in place by the timestamp reported by Time, which
+// orders v1, v6 and v7 UUIDs consistently even though v1 UUIDs do not sort
+// by time as bytes. UUIDs without a timestamp sort last, and ties are
+// broken by Compare.
+func SortByTime(ids []UUID) {
+	slices.SortStableFunc(ids, func(a, b UUID) int {
+		at, aerr := a.Time()
+		bt, berr := b.Time()
+		switch {
+		case aerr == nil && berr == nil:
+			if c := at.Compare(bt); c != 0 {
+				return c
+			}
+		case aerr == nil:
+			return -1
+		case berr == nil:
+			return 1
+		}
+		return Compare(a, b)
+	})
+}
+
+func compareKey(u UUID) ([16]byte, bool) {
+	if !isCanonicalUUID(string(u), true) {
+		return [16]byte{}, false
+	}
+	b, err := UUID(toLowerASCII(string(u))).Bytes()
+	return b, err == nil
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+	stdtime "time"
+)
+
+func TestCompare(t *testing.T) {
+	upper := UUID("A8098C1A-F86E-11DA-BDBF-10B96E4EF00D")
+	tests := []struct {
+		a, b UUID
+		want int
+	}{
+		{testUUID, testUUID, 0},
+		{upper, testUUID, 0},
+		{Nil(), testUUID, -1},
+		{"b0000000-0000-0000-0000-000000000000", upper, 1},
+		{"bogus", Nil(), -1},
+		{Nil(), "bogus", 1},
+		{"a", "b", -1},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Fatalf("Compare(%s, %s) = %d want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	// Plain string comparison gets mixed case wrong: "a8..." > "B0...".
+	lower, mixed := UUID("a8098c1a-f86e-11da-bdbf-10b96e4ef00d"), UUID("B0000000-0000-0000-0000-000000000000")
+	if string(lower) < string(mixed) || !Less(lower, mixed) {
+		t.Fatalf("Less(%s, %s) should be true", lower, mixed)
+	}
+}
+
+func TestBytes16Key(t *testing.T) {
+	k, err := testUUID.Key()
+	if err != nil {
+		t.Fatalf("Key error: %v", err)
+	}
+	m := map[Bytes16]int{k: 1}
+	k2, _ := FromBytes(k).Key()
+	if m[k2] != 1 || k.UUID() != testUUID || k.String() != string(testUUID) {
+		t.Fatalf("Bytes16 round trip failed")
+	}
+	if _, err := UUID("bogus").Key(); err == nil {
+		t.Fatalf("expected error for invalid UUID")
+	}
+}
+
+func TestSortByTime(t *testing.T) {
+	base := stdtime.Date(2022, 2, 22, 19, 22, 22, 0, stdtime.UTC)
+	var ids []UUID
+	for i := 0; i < 5; i++ {
+		at := base.Add(stdtime.Duration(4-i) * stdtime.Hour)
+		u, err := NewWithClock(nil, func() stdtime.Time { return at }).V1()
+		if err != nil {
+			t.Fatalf("V1 error: %v", err)
+		}
+		ids = append(ids, u)
+	}
+	v4, _ := V4()
+	ids = append([]UUID{v4}, ids...)
+	SortByTime(ids)
+	if ids[len(ids)-1] != v4 {
+		t.Fatalf("UUID without timestamp not last: %v", ids)
+	}
+	for i := 1; i < len(ids)-1; i++ {
+		a, _ := ids[i-1].Time()
+		b, _ := ids[i].Time()
+		if !a.Before(b) {
+			t.Fatalf("not sorted by time at %d: %v", i, ids)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	ids, err := NewWithClock(nil, func() stdtime.Time { return stdtime.UnixMilli(1_700_000_000_000) }).V7Batch(50)
+	if err != nil {
+		t.Fatalf("V7Batch error: %v", err)
+	}
+	shuffled := slices.Clone(ids)
+	slices.Reverse(shuffled)
+	Sort(shuffled)
+	if !slices.Equal(shuffled, ids) {
+		t.Fatalf("Sort did not restore creation order")
+	}
+}
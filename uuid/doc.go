@@ -1,7 +1,9 @@
-// Package uuid provides RFC 4122 v4 (random) and RFC 9562 v7
-// (time-ordered) UUID helpers built on randutil.
+// Package uuid provides RFC 4122 v4 (random), RFC 9562 v1/v6/v7
+// (time-based) and RFC 9562 v3/v5 (name-based) UUID helpers built on
+// randutil, plus the sortable KSUID and xid identifier formats.
 //
-// UUID v7 values encode Unix milliseconds for ordering, but this package does
-// not make UUID v7 values monotonic within the same millisecond. Generators are
-// concurrency-safe iff the injected RNG is safe.
+// UUID v7 values encode Unix milliseconds for ordering. They are not monotonic
+// within the same millisecond unless produced by V7Batch or by a generator
+// returned from Generator.Monotonic. Generators are concurrency-safe iff the
+// injected RNG is safe.
 package uuid
@@ -0,0 +1,125 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MarshalText implements encoding.TextMarshaler. The zero value marshals to
+// an empty string.
+func (u UUID) MarshalText() ([]byte, error) {
+	if u == "" {
+		return []byte{}, nil
+	}
+	if _, err := u.Bytes(); err != nil {
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// canonical form in any case, the braced form "{...}", the URN form
+// "urn:uuid:..." and 32 hex digits without hyphens. Empty input yields the
+// zero value.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*u = ""
+		return nil
+	}
+	v, err := parseLoose(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The zero value marshals to "", as
+// it did when UUID was encoded as a plain string; any other value is
+// validated like MarshalText so the output always unmarshals again.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts null and "" as the
+// zero value and any string form accepted by UnmarshalText.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*u = ""
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return ErrInvalidFormat
+	}
+	return u.UnmarshalText([]byte(s[1 : len(s)-1]))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the 16-byte form.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return b[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. data must be 16
+// bytes.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return ErrInvalidFormat
+	}
+	*u = fromBytes([16]byte(data))
+	return nil
+}
+
+// Scan implements sql.Scanner. It accepts NULL (the zero value), 16-byte
+// binary columns and any string form accepted by UnmarshalText.
+func (u *UUID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*u = ""
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == 16 {
+			return u.UnmarshalBinary(v)
+		}
+		return u.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidFormat, src)
+	}
+}
+
+// Value implements driver.Valuer. The zero value is stored as NULL and any
+// other UUID as its canonical string.
+func (u UUID) Value() (driver.Value, error) {
+	if u == "" {
+		return nil, nil
+	}
+	if _, err := u.Bytes(); err != nil {
+		return nil, err
+	}
+	return string(u), nil
+}
+
+// parseLoose parses the canonical, braced, URN and hyphen-less forms.
+func parseLoose(s string) (UUID, error) {
+	switch {
+	case len(s) == canonicalLen+2 && s[0] == '{' && s[len(s)-1] == '}':
+		s = s[1 : len(s)-1]
+	case len(s) == canonicalLen+9 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	case len(s) == 32:
+		s = s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+	}
+	return Parse(s)
+}
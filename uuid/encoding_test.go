@@ -0,0 +1,117 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler     = UUID("")
+	_ encoding.TextUnmarshaler   = (*UUID)(nil)
+	_ encoding.BinaryMarshaler   = UUID("")
+	_ encoding.BinaryUnmarshaler = (*UUID)(nil)
+	_ json.Marshaler             = UUID("")
+	_ json.Unmarshaler           = (*UUID)(nil)
+	_ sql.Scanner                = (*UUID)(nil)
+	_ driver.Valuer              = UUID("")
+)
+
+const testUUID = UUID("a8098c1a-f86e-11da-bdbf-10b96e4ef00d")
+
+func TestUnmarshalTextForms(t *testing.T) {
+	for _, in := range []string{
+		"a8098c1a-f86e-11da-bdbf-10b96e4ef00d",
+		"A8098C1A-F86E-11DA-BDBF-10B96E4EF00D",
+		"{a8098c1a-f86e-11da-bdbf-10b96e4ef00d}",
+		"urn:uuid:a8098c1a-f86e-11da-bdbf-10b96e4ef00d",
+		"URN:UUID:A8098C1A-F86E-11DA-BDBF-10B96E4EF00D",
+		"a8098c1af86e11dabdbf10b96e4ef00d",
+	} {
+		var u UUID
+		if err := u.UnmarshalText([]byte(in)); err != nil || u != testUUID {
+			t.Fatalf("UnmarshalText(%q) = %s err: %v", in, u, err)
+		}
+	}
+	for _, in := range []string{"{a8098c1a-f86e-11da-bdbf-10b96e4ef00d", "urn:a8098c1a-f86e-11da-bdbf-10b96e4ef00d", "a8098c1af86e11dabdbf10b96e4ef00g"} {
+		var u UUID
+		if err := u.UnmarshalText([]byte(in)); !errors.Is(err, ErrInvalidFormat) {
+			t.Fatalf("UnmarshalText(%q) err=%v", in, err)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type model struct {
+		ID     UUID `json:"id"`
+		Parent UUID `json:"parent"`
+	}
+	data, err := json.Marshal(model{ID: testUUID})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(data) != `{"id":"a8098c1a-f86e-11da-bdbf-10b96e4ef00d","parent":""}` {
+		t.Fatalf("Marshal = %s", data)
+	}
+	var got model
+	if err := json.Unmarshal([]byte(`{"id":"{A8098C1A-F86E-11DA-BDBF-10B96E4EF00D}","parent":null}`), &got); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if got.ID != testUUID || got.Parent != "" {
+		t.Fatalf("Unmarshal = %+v", got)
+	}
+	if err := json.Unmarshal(data, &got); err != nil || got.ID != testUUID || got.Parent != "" {
+		t.Fatalf("Unmarshal(%s) = %+v err: %v", data, got, err)
+	}
+	// An invalid value must fail to marshal rather than produce JSON that
+	// UnmarshalJSON rejects.
+	if data, err := json.Marshal(UUID("legacy-id")); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("Marshal invalid UUID = %s err=%v want ErrInvalidUUID", data, err)
+	}
+	if err := json.Unmarshal([]byte(`42`), &got.ID); err == nil {
+		t.Fatalf("expected error unmarshaling number")
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	b, err := testUUID.MarshalBinary()
+	if err != nil || len(b) != 16 {
+		t.Fatalf("MarshalBinary = %x err: %v", b, err)
+	}
+	var u UUID
+	if err := u.UnmarshalBinary(b); err != nil || u != testUUID {
+		t.Fatalf("UnmarshalBinary = %s err: %v", u, err)
+	}
+	if err := u.UnmarshalBinary(b[:15]); !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("short binary err=%v", err)
+	}
+}
+
+func TestSQLScanAndValue(t *testing.T) {
+	raw, _ := testUUID.MarshalBinary()
+	for _, src := range []any{string(testUUID), []byte(testUUID), raw, "urn:uuid:" + string(testUUID)} {
+		var u UUID
+		if err := u.Scan(src); err != nil || u != testUUID {
+			t.Fatalf("Scan(%v) = %s err: %v", src, u, err)
+		}
+	}
+	u := testUUID
+	if err := u.Scan(nil); err != nil || u != "" {
+		t.Fatalf("Scan(nil) = %q err: %v", u, err)
+	}
+	if err := u.Scan(42); !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("Scan(int) err=%v", err)
+	}
+	if v, err := testUUID.Value(); err != nil || v != string(testUUID) {
+		t.Fatalf("Value = %v err: %v", v, err)
+	}
+	if v, err := UUID("").Value(); err != nil || v != nil {
+		t.Fatalf("zero Value = %v err: %v", v, err)
+	}
+	if _, err := UUID("bogus").Value(); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("invalid Value err=%v", err)
+	}
+}
@@ -2,8 +2,11 @@ package uuid
 
 import "errors"
 
-// Package-level errors for UUID validation.
+// Package-level errors for UUID, KSUID and xid validation.
 var (
 	ErrInvalidFormat = errors.New("randutil: invalid UUID format")
 	ErrInvalidUUID   = errors.New("randutil: invalid UUID")
+	ErrInvalidKSUID  = errors.New("randutil: invalid KSUID")
+	ErrInvalidXID    = errors.New("randutil: invalid xid")
+	ErrNoTimestamp   = errors.New("randutil: UUID version has no timestamp")
 )
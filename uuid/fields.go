@@ -0,0 +1,122 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Variant is the layout family encoded in the top bits of byte 8.
+type Variant byte
+
+// Variants defined in RFC 9562, Section 4.1.
+const (
+	// VariantInvalid is reported for values that are not canonical UUIDs.
+	VariantInvalid Variant = iota
+	VariantNCS
+	VariantRFC9562
+	VariantMicrosoft
+	VariantFuture
+)
+
+// String returns the variant name.
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC9562:
+		return "RFC 9562"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	default:
+		return "Invalid"
+	}
+}
+
+// gregorianOffset is the number of 100-ns intervals between the Gregorian
+// epoch (1582-10-15) used by versions 1 and 6 and the Unix epoch.
+const gregorianOffset = 0x01b21dd213814000
+
+// ticksPerSecond is the number of 100-ns intervals per second.
+const ticksPerSecond = 10_000_000
+
+// FromBytes formats b as a canonical lower-case UUID.
+//
+// Parameters:
+//   - b: The 16 UUID bytes.
+//
+// Returns:
+//   - UUID: The canonical UUID.
+func FromBytes(b [16]byte) UUID {
+	return fromBytes(b)
+}
+
+// Version returns the version nibble of u, or 0 if u is not a canonical
+// lower-case UUID.
+func (u UUID) Version() int {
+	b, err := u.Bytes()
+	if err != nil {
+		return 0
+	}
+	return int(b[6] >> 4)
+}
+
+// Variant returns the variant of u, or VariantInvalid if u is not a
+// canonical lower-case UUID.
+func (u UUID) Variant() Variant {
+	b, err := u.Bytes()
+	if err != nil {
+		return VariantInvalid
+	}
+	switch {
+	case b[8]&0x80 == 0:
+		return VariantNCS
+	case b[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case b[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// Time returns the creation time encoded in a version 1, 6 or 7 UUID:
+// millisecond precision for v7 and 100-ns precision for v1 and v6.
+//
+// Returns:
+//   - time.Time: The timestamp in UTC.
+//   - error: ErrInvalidUUID if u is malformed or ErrNoTimestamp for other
+//     versions.
+func (u UUID) Time() (time.Time, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch b[6] >> 4 {
+	case 7:
+		var ts [8]byte
+		copy(ts[2:], b[:6])
+		// #nosec G115 -- a 48-bit value fits in int64.
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(ts[:]))).UTC(), nil
+	case 1:
+		low := uint64(binary.BigEndian.Uint32(b[0:4]))
+		mid := uint64(binary.BigEndian.Uint16(b[4:6]))
+		high := uint64(binary.BigEndian.Uint16(b[6:8]) & 0x0fff)
+		return gregorianTime(high<<48 | mid<<32 | low), nil
+	case 6:
+		high := uint64(binary.BigEndian.Uint32(b[0:4]))
+		mid := uint64(binary.BigEndian.Uint16(b[4:6]))
+		low := uint64(binary.BigEndian.Uint16(b[6:8]) & 0x0fff)
+		return gregorianTime(high<<28 | mid<<12 | low), nil
+	default:
+		return time.Time{}, ErrNoTimestamp
+	}
+}
+
+// gregorianTime converts a count of 100-ns intervals since 1582-10-15.
+func gregorianTime(ticks uint64) time.Time {
+	// #nosec G115 -- ticks has at most 60 bits.
+	t := int64(ticks) - gregorianOffset
+	return time.Unix(t/ticksPerSecond, (t%ticksPerSecond)*100).UTC()
+}
@@ -0,0 +1,72 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+	stdtime "time"
+)
+
+func TestFromBytes(t *testing.T) {
+	b, _ := testUUID.Bytes()
+	if got := FromBytes(b); got != testUUID {
+		t.Fatalf("FromBytes = %s", got)
+	}
+}
+
+func TestVersionAndVariant(t *testing.T) {
+	v4, _ := V4()
+	v7, _ := V7()
+	tests := []struct {
+		u       UUID
+		version int
+		variant Variant
+	}{
+		{v4, 4, VariantRFC9562},
+		{v7, 7, VariantRFC9562},
+		{testUUID, 1, VariantRFC9562},
+		{Nil(), 0, VariantNCS},
+		{"00000000-0000-0000-c000-000000000000", 0, VariantMicrosoft},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 15, VariantFuture},
+		{"bogus", 0, VariantInvalid},
+	}
+	for _, tt := range tests {
+		if got := tt.u.Version(); got != tt.version {
+			t.Fatalf("%s Version = %d want %d", tt.u, got, tt.version)
+		}
+		if got := tt.u.Variant(); got != tt.variant {
+			t.Fatalf("%s Variant = %v want %v", tt.u, got, tt.variant)
+		}
+	}
+	if VariantRFC9562.String() != "RFC 9562" || Variant(99).String() != "Invalid" {
+		t.Fatalf("unexpected Variant strings")
+	}
+}
+
+func TestTime(t *testing.T) {
+	// RFC 9562 Appendix A test vectors all encode 2022-02-22 19:22:22 UTC.
+	want := stdtime.Date(2022, 2, 22, 19, 22, 22, 0, stdtime.UTC)
+	for _, u := range []UUID{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846",
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+	} {
+		got, err := u.Time()
+		if err != nil {
+			t.Fatalf("%s Time error: %v", u, err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("%s Time = %v want %v", u, got, want)
+		}
+	}
+	got, _ := testUUID.Time()
+	if want := stdtime.Date(2006, 6, 10, 10, 48, 31, 13993000, stdtime.UTC); !got.Equal(want) {
+		t.Fatalf("v1 Time = %v want %v", got, want)
+	}
+	v4, _ := V4()
+	if _, err := v4.Time(); !errors.Is(err, ErrNoTimestamp) {
+		t.Fatalf("v4 Time err=%v", err)
+	}
+	if _, err := UUID("bogus").Time(); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("invalid Time err=%v", err)
+	}
+}
@@ -13,8 +13,11 @@ const maxV7Time = int64(1<<48 - 1)
 //
 // Concurrency: safe for concurrent use if the underlying RNG is safe.
 type Generator struct {
-	rng rng
-	now func() time.Time
+	rng  rng
+	now  func() time.Time
+	mono *monotonicState
+	xid  *xidState
+	v1   *v1State
 }
 
 // New returns a uuid Generator. If rng is nil, crypto/rand is used.
@@ -31,7 +34,7 @@ func NewWithClock(rng rng, now func() time.Time) *Generator {
 	if now == nil {
 		now = time.Now
 	}
-	return &Generator{rng: rng, now: now}
+	return &Generator{rng: rng, now: now, xid: &xidState{}, v1: &v1State{node: RandomNode()}}
 }
 
 // NewWithSource returns a uuid Generator bound to src.
@@ -70,7 +73,14 @@ func (g *Generator) V4() (UUID, error) {
 // Returns:
 //   - UUID: A random UUID conforming to Version 7 and Variant 1.
 //   - error: An error if entropy fails.
+//
+// On a generator returned by Monotonic, values are strictly increasing.
 func (g *Generator) V7() (UUID, error) {
+	if g.mono != nil {
+		g.mono.mu.Lock()
+		defer g.mono.mu.Unlock()
+		return g.nextMonotonicV7(g.mono)
+	}
 	b, err := g.rng.Bytes(16)
 	if err != nil {
 		return "", err
@@ -0,0 +1,54 @@
+package uuid
+
+import (
+	"iter"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// allBatch is the number of UUIDs whose entropy All reads at once.
+const allBatch = 256
+
+// All returns an iterator over n random v4 UUIDs from the default
+// generator.
+//
+// Parameters:
+//   - n: The number of UUIDs.
+//
+// Returns:
+//   - iter.Seq2[UUID, error]: The UUIDs; see Generator.All.
+func All(n int) iter.Seq2[UUID, error] {
+	return Default().All(n)
+}
+
+// All returns an iterator over n random v4 UUIDs. Entropy is read for up to
+// 256 UUIDs at a time, which avoids per-UUID read overhead when minting
+// millions of IDs. If n is negative or a read fails, the iterator yields a
+// single error and stops. Breaking out of the loop early discards the rest
+// of the current batch.
+func (g *Generator) All(n int) iter.Seq2[UUID, error] {
+	return func(yield func(UUID, error) bool) {
+		if n < 0 {
+			yield("", core.ErrNegativeLength)
+			return
+		}
+		for remaining := n; remaining > 0; {
+			k := min(remaining, allBatch)
+			buf, err := g.rng.Bytes(16 * k)
+			if err != nil {
+				yield("", err)
+				return
+			}
+			for i := 0; i < k; i++ {
+				var b [16]byte
+				copy(b[:], buf[16*i:])
+				b[6] = (b[6] & 0x0f) | 0x40 // version 4
+				b[8] = (b[8] & 0x3f) | 0x80 // variant 10xx
+				if !yield(fromBytes(b), nil) {
+					return
+				}
+			}
+			remaining -= k
+		}
+	}
+}
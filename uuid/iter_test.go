@@ -0,0 +1,90 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+type countingRNG struct {
+	rng
+	reads int
+}
+
+func (c *countingRNG) Bytes(n int) ([]byte, error) {
+	c.reads++
+	return c.rng.Bytes(n)
+}
+
+func TestAllBatchesReads(t *testing.T) {
+	counter := &countingRNG{rng: core.New(nil)}
+	seen := map[UUID]bool{}
+	for u, err := range New(counter).All(1000) {
+		if err != nil {
+			t.Fatalf("All error: %v", err)
+		}
+		if u.Version() != 4 || u.Variant() != VariantRFC9562 || seen[u] {
+			t.Fatalf("bad or duplicate UUID %s", u)
+		}
+		seen[u] = true
+	}
+	if len(seen) != 1000 {
+		t.Fatalf("got %d UUIDs want 1000", len(seen))
+	}
+	if counter.reads != 4 {
+		t.Fatalf("reads = %d want 4", counter.reads)
+	}
+}
+
+func TestAllMatchesV4(t *testing.T) {
+	data := make([]byte, 32)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	want0, _ := New(core.New(testutil.NewSeqReader(data[:16]))).V4()
+	want1, _ := New(core.New(testutil.NewSeqReader(data[16:]))).V4()
+	var got []UUID
+	for u, err := range New(core.New(testutil.NewSeqReader(data))).All(2) {
+		if err != nil {
+			t.Fatalf("All error: %v", err)
+		}
+		got = append(got, u)
+	}
+	if len(got) != 2 || got[0] != want0 || got[1] != want1 {
+		t.Fatalf("All = %v want [%s %s]", got, want0, want1)
+	}
+}
+
+func TestAllEarlyBreakAndErrors(t *testing.T) {
+	count := 0
+	for range All(10) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Fatalf("iterated %d times after break", count)
+	}
+	for _, err := range All(-1) {
+		if !errors.Is(err, core.ErrNegativeLength) {
+			t.Fatalf("negative n err=%v", err)
+		}
+	}
+	failing := New(core.New(testutil.ErrReader{Err: errors.New("entropy failure")}))
+	errs := 0
+	for _, err := range failing.All(5) {
+		if err == nil {
+			t.Fatalf("expected entropy error")
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Fatalf("got %d errors want 1", errs)
+	}
+	for range All(0) {
+		t.Fatalf("All(0) yielded a value")
+	}
+}
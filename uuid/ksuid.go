@@ -0,0 +1,83 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// KSUID is a 27-character base62 K-Sortable Unique IDentifier: a 32-bit
+// timestamp in seconds since ksuidEpoch followed by 128 random bits.
+// KSUIDs created in later seconds sort after earlier ones.
+type KSUID string
+
+const (
+	ksuidEpoch       = 1_400_000_000
+	ksuidLen         = 20
+	ksuidEncodedLen  = 27
+	ksuidBase62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// NewKSUID returns a KSUID for the current time.
+//
+// Returns:
+//   - KSUID: A new KSUID.
+//   - error: An error if the time is outside the KSUID range or if
+//     crypto/rand fails.
+func NewKSUID() (KSUID, error) {
+	return Default().KSUID()
+}
+
+// ParseKSUID validates s as a 27-character base62 KSUID.
+//
+// Parameters:
+//   - s: The string to parse.
+//
+// Returns:
+//   - KSUID: The KSUID.
+//   - error: ErrInvalidKSUID if s is malformed or out of range.
+func ParseKSUID(s string) (KSUID, error) {
+	if _, err := KSUID(s).Bytes(); err != nil {
+		return "", err
+	}
+	return KSUID(s), nil
+}
+
+// KSUID returns a KSUID for the generator's clock using its entropy source.
+func (g *Generator) KSUID() (KSUID, error) {
+	secs := g.nowUTC().Unix() - ksuidEpoch
+	if secs < 0 || secs > 1<<32-1 {
+		return "", core.ErrResultOutOfRange
+	}
+	r, err := g.rng.Bytes(ksuidLen - 4)
+	if err != nil {
+		return "", err
+	}
+	var b [ksuidLen]byte
+	// #nosec G115 -- secs lies in [0, 1<<32-1].
+	binary.BigEndian.PutUint32(b[:4], uint32(secs))
+	copy(b[4:], r)
+	return KSUID(encodeBase(b[:], ksuidEncodedLen, ksuidBase62Chars)), nil
+}
+
+// String returns the textual KSUID.
+func (k KSUID) String() string { return string(k) }
+
+// Bytes returns the 20-byte representation of k.
+func (k KSUID) Bytes() ([20]byte, error) {
+	var out [ksuidLen]byte
+	if len(k) != ksuidEncodedLen || !decodeBase(string(k), out[:], ksuidBase62Chars) {
+		return out, ErrInvalidKSUID
+	}
+	return out, nil
+}
+
+// Time returns the second-precision creation time encoded in k.
+func (k KSUID) Time() (time.Time, error) {
+	b, err := k.Bytes()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(b[:4]))+ksuidEpoch, 0).UTC(), nil
+}
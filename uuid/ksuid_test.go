@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	stdtime "time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestKSUIDReferenceVector(t *testing.T) {
+	k, err := ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if err != nil {
+		t.Fatalf("ParseKSUID error: %v", err)
+	}
+	b, _ := k.Bytes()
+	if got := hex.EncodeToString(b[:]); got != "0669f7efb5a1cd34b5f99d1154fb6853345c9735" {
+		t.Fatalf("Bytes = %s", got)
+	}
+	ts, _ := k.Time()
+	if ts.Unix() != 1507608047 {
+		t.Fatalf("Time = %v", ts)
+	}
+}
+
+func TestKSUIDRoundTrip(t *testing.T) {
+	payload, _ := hex.DecodeString("b5a1cd34b5f99d1154fb6853345c9735")
+	gen := NewWithClock(core.New(testutil.NewSeqReader(payload)),
+		func() stdtime.Time { return stdtime.Unix(1507608047, 0) })
+	k, err := gen.KSUID()
+	if err != nil {
+		t.Fatalf("KSUID error: %v", err)
+	}
+	if k != "0ujtsYcgvSTl8PAuAdqWYSMnLOv" {
+		t.Fatalf("KSUID = %s", k)
+	}
+	k2, err := NewKSUID()
+	if err != nil || len(k2) != 27 || k2 <= k {
+		t.Fatalf("NewKSUID = %s err: %v", k2, err)
+	}
+}
+
+func TestKSUIDBounds(t *testing.T) {
+	if _, err := ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V"); err != nil {
+		t.Fatalf("max KSUID rejected: %v", err)
+	}
+	for _, bad := range []string{"aWgEPTl1tmebfsQzFP4bxwgy80W", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"} {
+		if _, err := ParseKSUID(bad); !errors.Is(err, ErrInvalidKSUID) {
+			t.Fatalf("ParseKSUID(%q) err=%v", bad, err)
+		}
+	}
+	gen := NewWithClock(nil, func() stdtime.Time { return stdtime.Unix(ksuidEpoch-1, 0) })
+	if _, err := gen.KSUID(); !errors.Is(err, core.ErrResultOutOfRange) {
+		t.Fatalf("pre-epoch KSUID err=%v", err)
+	}
+}
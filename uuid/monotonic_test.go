@@ -0,0 +1,134 @@
+package uuid
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	stdtime "time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func fixedClock(ms int64) func() stdtime.Time {
+	return func() stdtime.Time { return stdtime.UnixMilli(ms) }
+}
+
+func TestV7BatchStrictlyIncreasing(t *testing.T) {
+	gen := NewWithClock(nil, fixedClock(1_700_000_000_000))
+	ids, err := gen.V7Batch(10000)
+	if err != nil {
+		t.Fatalf("V7Batch error: %v", err)
+	}
+	for i, u := range ids {
+		b, err := u.Bytes()
+		if err != nil {
+			t.Fatalf("Bytes error: %v", err)
+		}
+		if b[6]>>4 != 7 || b[8]&0xc0 != 0x80 {
+			t.Fatalf("%s has wrong version or variant", u)
+		}
+		if i > 0 && ids[i-1] >= u {
+			t.Fatalf("ids[%d]=%s not greater than ids[%d]=%s", i, u, i-1, ids[i-1])
+		}
+	}
+}
+
+func TestMonotonicCounterOverflowAdvancesTime(t *testing.T) {
+	// All-ones entropy seeds the counter at 2047, so 2049 UUIDs overflow it.
+	ones := make([]byte, 10*3000)
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	gen := NewWithClock(core.New(testutil.NewSeqReader(ones)), fixedClock(5000)).Monotonic()
+	var last UUID
+	for i := 0; i < 2050; i++ {
+		u, err := gen.V7()
+		if err != nil {
+			t.Fatalf("V7 error: %v", err)
+		}
+		if u <= last {
+			t.Fatalf("V7 not increasing: %s after %s", u, last)
+		}
+		last = u
+	}
+	b, _ := last.Bytes()
+	ts := int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 | int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+	if ts != 5001 {
+		t.Fatalf("timestamp %d want 5001 after counter overflow", ts)
+	}
+}
+
+func TestMonotonicSurvivesClockRegression(t *testing.T) {
+	now := int64(10_000)
+	var mu sync.Mutex
+	clock := func() stdtime.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return stdtime.UnixMilli(now)
+	}
+	gen := NewWithClock(nil, clock).Monotonic()
+	first, err := gen.V7()
+	if err != nil {
+		t.Fatalf("V7 error: %v", err)
+	}
+	mu.Lock()
+	now = 9_000
+	mu.Unlock()
+	second, err := gen.V7()
+	if err != nil {
+		t.Fatalf("V7 error: %v", err)
+	}
+	batch, err := gen.V7Batch(2)
+	if err != nil {
+		t.Fatalf("V7Batch error: %v", err)
+	}
+	if !(first < second && second < batch[0] && batch[0] < batch[1]) {
+		t.Fatalf("sequence not increasing: %s %s %v", first, second, batch)
+	}
+}
+
+func TestMonotonicConcurrent(t *testing.T) {
+	gen := New(nil).Monotonic()
+	const workers, per = 8, 500
+	results := make([][]UUID, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				u, err := gen.V7()
+				if err != nil {
+					t.Errorf("V7 error: %v", err)
+					return
+				}
+				results[w] = append(results[w], u)
+			}
+		}(w)
+	}
+	wg.Wait()
+	seen := map[UUID]bool{}
+	for _, rs := range results {
+		for i, u := range rs {
+			if seen[u] {
+				t.Fatalf("duplicate %s", u)
+			}
+			seen[u] = true
+			if i > 0 && rs[i-1] >= u {
+				t.Fatalf("per-goroutine order violated")
+			}
+		}
+	}
+}
+
+func TestV7BatchErrors(t *testing.T) {
+	if _, err := V7Batch(-1); !errors.Is(err, core.ErrNegativeLength) {
+		t.Fatalf("negative n err=%v", err)
+	}
+	gen := NewWithClock(nil, fixedClock(maxV7Time)).Monotonic()
+	gen.mono.started, gen.mono.lastMs, gen.mono.counter = true, maxV7Time, maxV7Counter
+	if _, err := gen.V7(); !errors.Is(err, core.ErrResultOutOfRange) {
+		t.Fatalf("exhausted timestamp err=%v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package uuid
+
+import (
+	// #nosec G501 -- MD5 is mandated by RFC 9562 for version 3 UUIDs.
+	"crypto/md5"
+	// #nosec G505 -- SHA-1 is mandated by RFC 9562 for version 5 UUIDs.
+	"crypto/sha1"
+	"hash"
+)
+
+// Namespace IDs defined in RFC 9562, Section 6.6.
+const (
+	NamespaceDNS  = UUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceURL  = UUID("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceOID  = UUID("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceX500 = UUID("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+)
+
+// V5 returns the RFC 9562 version 5 UUID for name within namespace. The
+// result is derived from SHA-1 and is always the same for the same inputs,
+// which makes it suitable for idempotency keys. It uses no entropy.
+//
+// Parameters:
+//   - namespace: The namespace UUID, e.g. NamespaceDNS.
+//   - name: The name within the namespace.
+//
+// Returns:
+//   - UUID: A name-based UUID conforming to Version 5 and Variant 1.
+//   - error: An error if namespace is not a canonical lower-case UUID.
+func V5(namespace UUID, name []byte) (UUID, error) {
+	return nameBased(sha1.New(), 0x50, namespace, name)
+}
+
+// V3 returns the RFC 9562 version 3 UUID for name within namespace. It is
+// derived from MD5; prefer V5 unless interoperating with existing v3 IDs.
+//
+// Parameters:
+//   - namespace: The namespace UUID, e.g. NamespaceDNS.
+//   - name: The name within the namespace.
+//
+// Returns:
+//   - UUID: A name-based UUID conforming to Version 3 and Variant 1.
+//   - error: An error if namespace is not a canonical lower-case UUID.
+func V3(namespace UUID, name []byte) (UUID, error) {
+	return nameBased(md5.New(), 0x30, namespace, name)
+}
+
+func nameBased(h hash.Hash, version byte, namespace UUID, name []byte) (UUID, error) {
+	ns, err := namespace.Bytes()
+	if err != nil {
+		return "", err
+	}
+	h.Write(ns[:])
+	h.Write(name)
+	var b [16]byte
+	copy(b[:], h.Sum(nil))
+	b[6] = (b[6] & 0x0f) | version
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10xx
+	return fromBytes(b), nil
+}
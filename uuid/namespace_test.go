@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNameBasedVectors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(UUID, []byte) (UUID, error)
+		ns   UUID
+		in   string
+		want UUID
+	}{
+		{"v5 dns", V5, NamespaceDNS, "www.example.com", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"v3 dns", V3, NamespaceDNS, "www.example.com", "5df41881-3aed-3515-88a7-2f4a814cf09e"},
+		{"v5 url", V5, NamespaceURL, "https://example.com/a", "6639460f-3425-5329-8097-a58f06127860"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.ns, []byte(tt.in))
+			if err != nil {
+				t.Fatalf("error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %s want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameBasedInvalidNamespace(t *testing.T) {
+	if _, err := V5("not-a-uuid", []byte("x")); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("V5 err=%v want ErrInvalidUUID", err)
+	}
+	if _, err := V3("6BA7B810-9DAD-11D1-80B4-00C04FD430C8", []byte("x")); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("V3 upper-case namespace err=%v want ErrInvalidUUID", err)
+	}
+}
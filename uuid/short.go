@@ -0,0 +1,38 @@
+package uuid
+
+// shortAlphabet is the base57 alphabet used by the shortuuid libraries; it
+// omits the look-alike characters 0 1 I O l.
+const shortAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// shortLen is the number of base57 digits needed for 128 bits.
+const shortLen = 22
+
+// Short returns u as a 22-character base57 string, most significant digit
+// first, compatible with Python shortuuid 1.0+ and lithammer/shortuuid v4.
+//
+// Returns:
+//   - string: The short form.
+//   - error: An error if u is not a canonical lower-case UUID.
+func (u UUID) Short() (string, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return encodeBase(b[:], shortLen, shortAlphabet), nil
+}
+
+// ParseShort decodes a 22-character base57 string produced by Short.
+//
+// Parameters:
+//   - s: The short form.
+//
+// Returns:
+//   - UUID: The canonical UUID.
+//   - error: ErrInvalidFormat if s is malformed or out of range.
+func ParseShort(s string) (UUID, error) {
+	var b [16]byte
+	if len(s) != shortLen || !decodeBase(s, b[:], shortAlphabet) {
+		return "", ErrInvalidFormat
+	}
+	return fromBytes(b), nil
+}
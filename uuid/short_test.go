@@ -0,0 +1,44 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShortRoundTrip(t *testing.T) {
+	s, err := testUUID.Short()
+	if err != nil || s != "XuAcFm7x4jsnHeffwZZzpt" {
+		t.Fatalf("Short = %q err: %v", s, err)
+	}
+	u, err := ParseShort(s)
+	if err != nil || u != testUUID {
+		t.Fatalf("ParseShort = %s err: %v", u, err)
+	}
+	if s, _ := Nil().Short(); s != "2222222222222222222222" {
+		t.Fatalf("nil Short = %q", s)
+	}
+	for i := 0; i < 100; i++ {
+		v, _ := V4()
+		s, err := v.Short()
+		if err != nil || len(s) != 22 {
+			t.Fatalf("Short(%s) = %q err: %v", v, s, err)
+		}
+		if back, err := ParseShort(s); err != nil || back != v {
+			t.Fatalf("round trip %s -> %q -> %s err: %v", v, s, back, err)
+		}
+	}
+}
+
+func TestShortErrors(t *testing.T) {
+	if _, err := ParseShort("oZEq7ovRbLq6UnGMPwc8B5"); err != nil {
+		t.Fatalf("max value rejected: %v", err)
+	}
+	for _, bad := range []string{"oZEq7ovRbLq6UnGMPwc8B6", "XuAcFm7x4jsnHeffwZZzp", "XuAcFm7x4jsnHeffwZZzp0"} {
+		if _, err := ParseShort(bad); !errors.Is(err, ErrInvalidFormat) {
+			t.Fatalf("ParseShort(%q) err=%v", bad, err)
+		}
+	}
+	if _, err := UUID("bogus").Short(); !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("invalid Short err=%v", err)
+	}
+}
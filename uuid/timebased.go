@@ -0,0 +1,190 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sync"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+type nodeKind int
+
+const (
+	nodeRandom nodeKind = iota
+	nodeHardware
+	nodeFixed
+)
+
+// Node selects the 48-bit node ID embedded in V1 and V6 UUIDs.
+type Node struct {
+	kind nodeKind
+	id   [6]byte
+}
+
+// RandomNode draws a random node ID with the multicast bit set, as RFC 9562
+// recommends when no IEEE 802 address should be exposed. It is the default.
+func RandomNode() Node { return Node{kind: nodeRandom} }
+
+// HardwareNode uses the MAC address of the first non-loopback network
+// interface, falling back to RandomNode if none is found. The UUIDs then
+// reveal the host's MAC address.
+func HardwareNode() Node { return Node{kind: nodeHardware} }
+
+// FixedNode uses id verbatim, e.g. a node ID assigned per service instance.
+func FixedNode(id [6]byte) Node { return Node{kind: nodeFixed, id: id} }
+
+// v1State holds the node ID, clock sequence and last timestamp shared by
+// V1 and V6.
+type v1State struct {
+	mu        sync.Mutex
+	node      Node
+	ready     bool
+	nodeID    [6]byte
+	clockSeq  uint16
+	lastTicks uint64
+}
+
+// WithNode returns a generator sharing g's entropy source and clock that
+// embeds node in V1 and V6 UUIDs. It starts a fresh clock sequence.
+func (g *Generator) WithNode(node Node) *Generator {
+	c := *g
+	c.v1 = &v1State{node: node}
+	return &c
+}
+
+// V1 returns a RFC 9562 version 1 (Gregorian time-based) UUID with a random
+// multicast node ID.
+//
+// Returns:
+//   - UUID: A UUID conforming to Version 1 and Variant 1.
+//   - error: An error if the time is out of range or if crypto/rand fails.
+func V1() (UUID, error) {
+	return Default().V1()
+}
+
+// V6 returns a RFC 9562 version 6 UUID: the fields of V1 reordered so that
+// the textual form sorts by time.
+//
+// Returns:
+//   - UUID: A UUID conforming to Version 6 and Variant 1.
+//   - error: An error if the time is out of range or if crypto/rand fails.
+func V6() (UUID, error) {
+	return Default().V6()
+}
+
+// V1 returns a version 1 UUID using the generator's clock, node ID and
+// entropy source. The 14-bit clock sequence starts at a random value and is
+// incremented whenever the clock does not advance, so values stay unique
+// within a generator when the clock stalls or steps backwards.
+func (g *Generator) V1() (UUID, error) {
+	ticks, seq, node, err := g.nextGregorian()
+	if err != nil {
+		return "", err
+	}
+	var b [16]byte
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint32(b[0:4], uint32(ticks))
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint16(b[4:6], uint16(ticks>>32))
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint16(b[6:8], uint16(ticks>>48)&0x0fff|0x1000)
+	putClockSeqNode(b[8:], seq, node)
+	return fromBytes(b), nil
+}
+
+// V6 returns a version 6 UUID using the generator's clock, node ID and
+// entropy source, with the same clock sequence handling as V1.
+func (g *Generator) V6() (UUID, error) {
+	ticks, seq, node, err := g.nextGregorian()
+	if err != nil {
+		return "", err
+	}
+	var b [16]byte
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint32(b[0:4], uint32(ticks>>28))
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint16(b[4:6], uint16(ticks>>12))
+	// #nosec G115 -- each field is masked to its width.
+	binary.BigEndian.PutUint16(b[6:8], uint16(ticks)&0x0fff|0x6000)
+	putClockSeqNode(b[8:], seq, node)
+	return fromBytes(b), nil
+}
+
+func putClockSeqNode(b []byte, seq uint16, node [6]byte) {
+	b[0] = 0x80 | byte(seq>>8)&0x3f // variant 10xx
+	b[1] = byte(seq)
+	copy(b[2:], node[:])
+}
+
+// nextGregorian returns the current 100-ns Gregorian timestamp with the
+// clock sequence and node ID to use for it.
+func (g *Generator) nextGregorian() (uint64, uint16, [6]byte, error) {
+	now := g.nowUTC()
+	secs := now.Unix()
+	if secs < -gregorianOffset/ticksPerSecond || secs >= (1<<60-gregorianOffset)/ticksPerSecond {
+		return 0, 0, [6]byte{}, core.ErrResultOutOfRange
+	}
+	ticks := secs*ticksPerSecond + int64(now.Nanosecond()/100) + gregorianOffset
+	st := g.v1
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if !st.ready {
+		if err := g.initV1(st); err != nil {
+			return 0, 0, [6]byte{}, err
+		}
+	}
+	// #nosec G115 -- ticks lies in [0, 1<<60).
+	t := uint64(ticks)
+	if t <= st.lastTicks {
+		st.clockSeq = (st.clockSeq + 1) & 0x3fff
+	}
+	st.lastTicks = t
+	return t, st.clockSeq, st.nodeID, nil
+}
+
+// initV1 resolves the node ID and draws the initial clock sequence. The
+// caller holds st.mu.
+func (g *Generator) initV1(st *v1State) error {
+	r, err := g.rng.Bytes(8)
+	if err != nil {
+		return err
+	}
+	st.clockSeq = binary.BigEndian.Uint16(r[:2]) & 0x3fff
+	switch st.node.kind {
+	case nodeFixed:
+		st.nodeID = st.node.id
+	case nodeHardware:
+		if mac, ok := hardwareAddr(); ok {
+			st.nodeID = mac
+			break
+		}
+		fallthrough
+	default:
+		copy(st.nodeID[:], r[2:])
+		st.nodeID[0] |= 0x01 // multicast bit marks a non-IEEE node ID
+	}
+	st.ready = true
+	return nil
+}
+
+// hardwareAddr returns the first usable 48-bit interface MAC address.
+func hardwareAddr() ([6]byte, bool) {
+	var mac [6]byte
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return mac, false
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 || len(iface.HardwareAddr) != 6 {
+			continue
+		}
+		if bytes.Equal(iface.HardwareAddr, make([]byte, 6)) {
+			continue
+		}
+		copy(mac[:], iface.HardwareAddr)
+		return mac, true
+	}
+	return mac, false
+}
@@ -0,0 +1,104 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+	stdtime "time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+var rfcNode = [6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}
+
+func rfcClock() stdtime.Time {
+	return stdtime.Date(2022, 2, 22, 19, 22, 22, 0, stdtime.UTC)
+}
+
+func TestV1V6RFCVectors(t *testing.T) {
+	// Clock sequence 0x33c8 as in RFC 9562 Appendix A.
+	seed := []byte{0x33, 0xc8, 0, 0, 0, 0, 0, 0}
+	gen := NewWithClock(core.New(testutil.NewSeqReader(seed)), rfcClock).WithNode(FixedNode(rfcNode))
+	v1, err := gen.V1()
+	if err != nil {
+		t.Fatalf("V1 error: %v", err)
+	}
+	if v1 != "c232ab00-9414-11ec-b3c8-9f6bdeced846" {
+		t.Fatalf("V1 = %s", v1)
+	}
+	gen = NewWithClock(core.New(testutil.NewSeqReader(seed)), rfcClock).WithNode(FixedNode(rfcNode))
+	v6, err := gen.V6()
+	if err != nil {
+		t.Fatalf("V6 error: %v", err)
+	}
+	if v6 != "1ec9414c-232a-6b00-b3c8-9f6bdeced846" {
+		t.Fatalf("V6 = %s", v6)
+	}
+	// The clock did not advance, so the clock sequence is incremented.
+	next, err := gen.V6()
+	if err != nil {
+		t.Fatalf("V6 error: %v", err)
+	}
+	if next != "1ec9414c-232a-6b00-b3c9-9f6bdeced846" {
+		t.Fatalf("second V6 = %s", next)
+	}
+}
+
+func TestV1RandomNodeIsMulticast(t *testing.T) {
+	for _, gen := range []*Generator{New(nil), New(nil).WithNode(RandomNode())} {
+		u, err := gen.V1()
+		if err != nil {
+			t.Fatalf("V1 error: %v", err)
+		}
+		b, _ := u.Bytes()
+		if b[10]&0x01 == 0 {
+			t.Fatalf("random node %x lacks multicast bit", b[10:])
+		}
+		if u.Version() != 1 || u.Variant() != VariantRFC9562 {
+			t.Fatalf("V1 %s has wrong version or variant", u)
+		}
+	}
+}
+
+func TestV1HardwareNode(t *testing.T) {
+	u, err := New(nil).WithNode(HardwareNode()).V1()
+	if err != nil {
+		t.Fatalf("V1 error: %v", err)
+	}
+	b, _ := u.Bytes()
+	if mac, ok := hardwareAddr(); ok && [6]byte(b[10:]) != mac {
+		t.Fatalf("node %x want %x", b[10:], mac)
+	}
+}
+
+func TestV6SortsByTime(t *testing.T) {
+	now := rfcClock()
+	gen := NewWithClock(nil, func() stdtime.Time { return now })
+	var last UUID
+	for i := 0; i < 100; i++ {
+		u, err := gen.V6()
+		if err != nil {
+			t.Fatalf("V6 error: %v", err)
+		}
+		if u <= last {
+			t.Fatalf("V6 not increasing: %s after %s", u, last)
+		}
+		got, err := u.Time()
+		if err != nil || !got.Equal(now) {
+			t.Fatalf("V6 Time = %v err: %v want %v", got, err, now)
+		}
+		last = u
+		now = now.Add(stdtime.Microsecond)
+	}
+}
+
+func TestV1Errors(t *testing.T) {
+	gen := NewWithClock(nil, func() stdtime.Time { return stdtime.Date(1500, 1, 1, 0, 0, 0, 0, stdtime.UTC) })
+	if _, err := gen.V1(); !errors.Is(err, core.ErrResultOutOfRange) {
+		t.Fatalf("pre-Gregorian err=%v", err)
+	}
+	gen = New(core.New(testutil.ErrReader{Err: errors.New("entropy failure")}))
+	if _, err := gen.V6(); err == nil {
+		t.Fatalf("expected entropy error")
+	}
+}
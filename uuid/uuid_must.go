@@ -42,3 +42,39 @@ func MustParse(s string) UUID {
 	}
 	return u
 }
+
+// MustV5 returns a name-based v5 UUID or panics.
+func MustV5(namespace UUID, name []byte) UUID {
+	u, err := V5(namespace, name)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// MustV3 returns a name-based v3 UUID or panics.
+func MustV3(namespace UUID, name []byte) UUID {
+	u, err := V3(namespace, name)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// MustV1 returns a time-based v1 UUID or panics.
+func MustV1() UUID {
+	u, err := V1()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// MustV6 returns a time-ordered v6 UUID or panics.
+func MustV6() UUID {
+	u, err := V6()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
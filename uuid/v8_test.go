@@ -0,0 +1,19 @@
+package uuid
+
+import "testing"
+
+func TestV8(t *testing.T) {
+	// RFC 9562 Appendix B.1 example.
+	in := [16]byte{0x24, 0x89, 0xe9, 0xad, 0x2e, 0xe2, 0x0e, 0x00, 0x0e, 0xc9, 0x32, 0xd5, 0xf6, 0x91, 0x81, 0xc0}
+	if got := V8(in); got != "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0" {
+		t.Fatalf("V8 = %s", got)
+	}
+	var ones [16]byte
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	u := V8(ones)
+	if u != "ffffffff-ffff-8fff-bfff-ffffffffffff" || u.Version() != 8 || u.Variant() != VariantRFC9562 {
+		t.Fatalf("V8(ones) = %s", u)
+	}
+}
@@ -0,0 +1,118 @@
+package uuid
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aatuh/randutil/v2/core"
+)
+
+// XID is a 20-character xid: 12 bytes holding a 32-bit Unix timestamp in
+// seconds, a 3-byte machine ID, a 2-byte process ID and a 3-byte counter,
+// encoded as lower-case base32hex without padding. It is compatible with
+// github.com/rs/xid.
+type XID string
+
+const (
+	xidLen        = 12
+	xidEncodedLen = 20
+)
+
+var xidEncoding = base32.NewEncoding("0123456789abcdefghijklmnopqrstuv").WithPadding(base32.NoPadding)
+
+// xidState holds the per-generator machine ID and counter. The machine ID
+// is random rather than derived from the host name, so IDs do not reveal
+// which host created them.
+type xidState struct {
+	mu      sync.Mutex
+	ready   bool
+	machine [3]byte
+	counter uint32
+}
+
+// NewXID returns an xid for the current time.
+//
+// Returns:
+//   - XID: A new xid.
+//   - error: An error if the time is outside the xid range or if
+//     crypto/rand fails.
+func NewXID() (XID, error) {
+	return Default().XID()
+}
+
+// ParseXID validates s as a 20-character xid.
+//
+// Parameters:
+//   - s: The string to parse.
+//
+// Returns:
+//   - XID: The xid.
+//   - error: ErrInvalidXID if s is malformed.
+func ParseXID(s string) (XID, error) {
+	if _, err := XID(s).Bytes(); err != nil {
+		return "", err
+	}
+	return XID(s), nil
+}
+
+// XID returns an xid for the generator's clock. The machine ID and initial
+// counter are drawn from the generator's entropy source on first use.
+func (g *Generator) XID() (XID, error) {
+	secs := g.nowUTC().Unix()
+	if secs < 0 || secs > 1<<32-1 {
+		return "", core.ErrResultOutOfRange
+	}
+	st := g.xid
+	st.mu.Lock()
+	if !st.ready {
+		r, err := g.rng.Bytes(6)
+		if err != nil {
+			st.mu.Unlock()
+			return "", err
+		}
+		copy(st.machine[:], r[:3])
+		st.counter = uint32(r[3])<<16 | uint32(r[4])<<8 | uint32(r[5])
+		st.ready = true
+	}
+	machine, counter := st.machine, st.counter
+	st.counter = (st.counter + 1) & 0xffffff
+	st.mu.Unlock()
+
+	var b [xidLen]byte
+	// #nosec G115 -- secs lies in [0, 1<<32-1].
+	binary.BigEndian.PutUint32(b[:4], uint32(secs))
+	copy(b[4:7], machine[:])
+	// #nosec G115 -- the process ID is truncated to 16 bits by design.
+	binary.BigEndian.PutUint16(b[7:9], uint16(os.Getpid()))
+	b[9], b[10], b[11] = byte(counter>>16), byte(counter>>8), byte(counter)
+	return XID(xidEncoding.EncodeToString(b[:])), nil
+}
+
+// String returns the textual xid.
+func (x XID) String() string { return string(x) }
+
+// Bytes returns the 12-byte representation of x.
+func (x XID) Bytes() ([12]byte, error) {
+	var out [xidLen]byte
+	if len(x) != xidEncodedLen {
+		return out, ErrInvalidXID
+	}
+	n, err := xidEncoding.Decode(out[:], []byte(x))
+	// Re-encoding rejects non-canonical trailing bits.
+	if err != nil || n != xidLen || xidEncoding.EncodeToString(out[:]) != string(x) {
+		return out, ErrInvalidXID
+	}
+	return out, nil
+}
+
+// Time returns the second-precision creation time encoded in x.
+func (x XID) Time() (time.Time, error) {
+	b, err := x.Bytes()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(b[:4])), 0).UTC(), nil
+}
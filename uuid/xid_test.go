@@ -0,0 +1,67 @@
+package uuid
+
+import (
+	"errors"
+	"os"
+	"testing"
+	stdtime "time"
+
+	"github.com/aatuh/randutil/v2/core"
+	"github.com/aatuh/randutil/v2/internal/testutil"
+)
+
+func TestXIDReferenceVector(t *testing.T) {
+	x, err := ParseXID("9m4e2mr0ui3e8a215n4g")
+	if err != nil {
+		t.Fatalf("ParseXID error: %v", err)
+	}
+	b, _ := x.Bytes()
+	want := [12]byte{0x4d, 0x88, 0xe1, 0x5b, 0x60, 0xf4, 0x86, 0xe4, 0x28, 0x41, 0x2d, 0xc9}
+	if b != want {
+		t.Fatalf("Bytes = %x want %x", b, want)
+	}
+	ts, _ := x.Time()
+	if ts.Unix() != 1300816219 {
+		t.Fatalf("Time = %v", ts)
+	}
+}
+
+func TestXIDLayout(t *testing.T) {
+	gen := NewWithClock(core.New(testutil.NewSeqReader([]byte{0x60, 0xf4, 0x86, 0xff, 0xff, 0xff})),
+		func() stdtime.Time { return stdtime.Unix(1300816219, 0) })
+	first, err := gen.XID()
+	if err != nil {
+		t.Fatalf("XID error: %v", err)
+	}
+	second, err := gen.XID()
+	if err != nil {
+		t.Fatalf("XID error: %v", err)
+	}
+	a, _ := first.Bytes()
+	b, _ := second.Bytes()
+	if a[4] != 0x60 || a[5] != 0xf4 || a[6] != 0x86 {
+		t.Fatalf("machine ID = %x", a[4:7])
+	}
+	if pid := uint16(a[7])<<8 | uint16(a[8]); pid != uint16(os.Getpid()) {
+		t.Fatalf("pid = %d want %d", pid, uint16(os.Getpid()))
+	}
+	if a[9] != 0xff || b[9] != 0 || b[10] != 0 || b[11] != 0 {
+		t.Fatalf("counter did not wrap: %x then %x", a[9:], b[9:])
+	}
+}
+
+func TestXIDErrors(t *testing.T) {
+	for _, bad := range []string{"", "9m4e2mr0ui3e8a215n4", "9m4e2mr0ui3e8a215n4h", "9M4E2MR0UI3E8A215N4G"} {
+		if _, err := ParseXID(bad); !errors.Is(err, ErrInvalidXID) {
+			t.Fatalf("ParseXID(%q) err=%v", bad, err)
+		}
+	}
+	gen := New(core.New(testutil.ErrReader{Err: errors.New("entropy failure")}))
+	if _, err := gen.XID(); err == nil {
+		t.Fatalf("expected entropy error")
+	}
+	x, err := NewXID()
+	if err != nil || len(x) != 20 {
+		t.Fatalf("NewXID = %s err: %v", x, err)
+	}
+}
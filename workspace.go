@@ -6,11 +6,16 @@ import (
 	"sync"
 
 	"github.com/aatuh/randutil/v2/adapters"
+	"github.com/aatuh/randutil/v2/color"
 	"github.com/aatuh/randutil/v2/core"
 	"github.com/aatuh/randutil/v2/dist"
 	"github.com/aatuh/randutil/v2/email"
+	"github.com/aatuh/randutil/v2/fake"
+	"github.com/aatuh/randutil/v2/geo"
 	"github.com/aatuh/randutil/v2/nanoid"
 	"github.com/aatuh/randutil/v2/numeric"
+	"github.com/aatuh/randutil/v2/randdata"
+	"github.com/aatuh/randutil/v2/randnet"
 	"github.com/aatuh/randutil/v2/randstring"
 	"github.com/aatuh/randutil/v2/randtime"
 	"github.com/aatuh/randutil/v2/ulid"
@@ -167,6 +172,11 @@ func (w *Workspace) Rand(label string) (Rand, error) {
 		Email:   email.New(gen),
 		NanoID:  nanoid.New(gen),
 		ULID:    ulid.New(gen),
+		Fake:    fake.New(gen),
+		Net:     randnet.New(gen),
+		Geo:     geo.New(gen),
+		Color:   color.New(gen),
+		Data:    randdata.New(gen),
 	}, nil
 }
 